@@ -156,3 +156,69 @@ func (q Query) AggregateWithSeedByT(seed interface{},
 
 	return q.AggregateWithSeedBy(seed, fFunc, resultSelectorFunc)
 }
+
+// AggregateByKey groups the elements of a collection according to a specified
+// key selector function and applies an accumulator function over each group,
+// in a single pass. Function seed is called once for every distinct key to
+// create the initial accumulator value of the group, and the result of f
+// replaces the previous accumulated value of the group.
+//
+// AggregateByKey returns a map from each key to the final accumulated value of
+// its group. Unlike GroupBy followed by an aggregation, it doesn't keep the
+// elements of the groups in memory.
+func (q Query) AggregateByKey(keySelector func(interface{}) interface{},
+	seed func() interface{},
+	f func(interface{}, interface{}) interface{}) map[interface{}]interface{} {
+
+	next := q.Iterate()
+	result := make(map[interface{}]interface{})
+
+	for current, ok := next(); ok; current, ok = next() {
+		key := keySelector(current)
+
+		acc, has := result[key]
+		if !has {
+			acc = seed()
+		}
+
+		result[key] = f(acc, current)
+	}
+
+	return result
+}
+
+// AggregateByKeyT is the typed version of AggregateByKey.
+//
+//   - keySelectorFn is of type "func(TSource) TKey"
+//   - f is of type "func(TAccumulate, TSource) TAccumulate"
+//
+// NOTE: AggregateByKey has better performance than AggregateByKeyT.
+func (q Query) AggregateByKeyT(keySelectorFn interface{},
+	seed func() interface{},
+	f interface{}) map[interface{}]interface{} {
+	keySelectorGenericFunc, err := newGenericFunc(
+		"AggregateByKeyT", "keySelectorFn", keySelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	keySelectorFunc := func(item interface{}) interface{} {
+		return keySelectorGenericFunc.Call(item)
+	}
+
+	fGenericFunc, err := newGenericFunc(
+		"AggregateByKeyT", "f", f,
+		simpleParamValidator(newElemTypeSlice(new(genericType), new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	fFunc := func(result interface{}, current interface{}) interface{} {
+		return fGenericFunc.Call(result, current)
+	}
+
+	return q.AggregateByKey(keySelectorFunc, seed, fFunc)
+}
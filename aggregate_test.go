@@ -2,6 +2,7 @@ package linq
 
 import "testing"
 import "strings"
+import "reflect"
 
 func TestAggregate(t *testing.T) {
 	tests := []struct {
@@ -117,3 +118,39 @@ func TestAggregateWithSeedByT_PanicWhenResultSelectorFnIsInvalid(t *testing.T) {
 		)
 	})
 }
+
+func TestAggregateByKey(t *testing.T) {
+	input := []string{"apple", "avocado", "banana", "blueberry", "cherry"}
+	want := map[interface{}]interface{}{"a": 12, "b": 15, "c": 6}
+
+	r := From(input).AggregateByKey(
+		func(i interface{}) interface{} { return i.(string)[:1] },
+		func() interface{} { return 0 },
+		func(acc interface{}, i interface{}) interface{} { return acc.(int) + len(i.(string)) },
+	)
+
+	if !reflect.DeepEqual(r, want) {
+		t.Errorf("From(%v).AggregateByKey()=%v expected %v", input, r, want)
+	}
+
+	r = From(input).AggregateByKeyT(
+		func(s string) int { return len(s) },
+		func() interface{} { return []string{} },
+		func(acc []string, s string) []string { return append(acc, s) },
+	)
+
+	want = map[interface{}]interface{}{5: []string{"apple"}, 6: []string{"banana", "cherry"}, 7: []string{"avocado"}, 9: []string{"blueberry"}}
+	if !reflect.DeepEqual(r, want) {
+		t.Errorf("From(%v).AggregateByKeyT()=%v expected %v", input, r, want)
+	}
+}
+
+func TestAggregateByKeyT_PanicWhenFunctionIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "AggregateByKeyT: parameter [f] has a invalid function signature. Expected: 'func(T,T)T', actual: 'func(int)int'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).AggregateByKeyT(
+			func(i int) int { return i },
+			func() interface{} { return 0 },
+			func(i int) int { return i },
+		)
+	})
+}
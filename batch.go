@@ -0,0 +1,111 @@
+package linq
+
+// BatchWhile splits a collection into batches of contiguous elements. Function
+// predicate is invoked for every element with the current batch and the
+// element: the element is added to the batch if predicate returns true,
+// otherwise the batch is returned and a new batch starts with the element.
+// predicate is never invoked with an empty batch.
+//
+// BatchWhile allows batches of variable size, for example to put records in a
+// batch until their total size reaches a budget. Each batch is a new slice.
+func (q Query) BatchWhile(predicate func(batch []interface{}, item interface{}) bool) Query {
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			var batch []interface{}
+			done := false
+
+			return func() (item interface{}, ok bool) {
+				for !done {
+					it, ok := next()
+					if !ok {
+						done = true
+						break
+					}
+
+					if len(batch) == 0 || predicate(batch, it) {
+						batch = append(batch, it)
+						continue
+					}
+
+					item, batch = batch, []interface{}{it}
+					return item, true
+				}
+
+				if len(batch) > 0 {
+					item, batch = batch, nil
+					return item, true
+				}
+
+				return nil, false
+			}
+		},
+	}
+}
+
+// Paginate splits a collection into pages of pageSize contiguous elements. Each
+// page is a new slice, and the last page can contain fewer than pageSize
+// elements. If pageSize is not positive, the result is an empty collection.
+func (q Query) Paginate(pageSize int) Query {
+	if pageSize <= 0 {
+		return From([]interface{}{})
+	}
+
+	return q.BatchWhile(func(batch []interface{}, item interface{}) bool {
+		return len(batch) < pageSize
+	})
+}
+
+// Page returns the elements of the specified page of a collection split into
+// pages of pageSize elements. Pages are numbered from 1. If pageNumber is past
+// the last page, or if pageNumber or pageSize is not positive, the result is an
+// empty collection.
+func (q Query) Page(pageNumber, pageSize int) Query {
+	if pageNumber <= 0 || pageSize <= 0 || pageNumber-1 > maxInt/pageSize {
+		return From([]interface{}{})
+	}
+
+	return q.Skip((pageNumber - 1) * pageSize).Take(pageSize)
+}
+
+// ChunkByWeight splits a collection into chunks of contiguous elements whose
+// total weight doesn't exceed maxWeight. Function weight is invoked once for
+// every element: the element is added to the current chunk unless that would
+// make the total weight of the chunk exceed maxWeight, in which case a new
+// chunk starts with the element. Each chunk is a new slice.
+//
+// An element heavier than maxWeight is put in a chunk of its own, so such a
+// chunk exceeds the budget; check the weights beforehand if that is not
+// acceptable.
+//
+// ChunkByWeight is not deferred: the source collection is enumerated when the
+// method is called. The chunking stops at the first element for which weight
+// returns an error, and that error is returned.
+func (q Query) ChunkByWeight(maxWeight float64,
+	weight func(interface{}) (float64, error)) (Query, error) {
+	next := q.Iterate()
+
+	var chunks []interface{}
+	var chunk []interface{}
+	total := 0.0
+	for item, ok := next(); ok; item, ok = next() {
+		w, err := weight(item)
+		if err != nil {
+			return Query{}, err
+		}
+
+		if len(chunk) > 0 && total+w > maxWeight {
+			chunks = append(chunks, chunk)
+			chunk, total = nil, 0
+		}
+
+		chunk = append(chunk, item)
+		total += w
+	}
+
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+
+	return From(chunks), nil
+}
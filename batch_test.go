@@ -0,0 +1,125 @@
+package linq
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestBatchWhile(t *testing.T) {
+	budget := func(batch []interface{}, item interface{}) bool {
+		total := item.(int)
+		for _, i := range batch {
+			total += i.(int)
+		}
+		return total <= 10
+	}
+
+	tests := []struct {
+		input  interface{}
+		output []interface{}
+	}{
+		{[]int{3, 4, 2, 8, 1, 12, 5}, []interface{}{
+			[]interface{}{3, 4, 2}, []interface{}{8, 1}, []interface{}{12}, []interface{}{5},
+		}},
+		{[]int{11}, []interface{}{[]interface{}{11}}},
+		{[]int{}, nil},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).BatchWhile(budget).Results(); !reflect.DeepEqual(r, test.output) {
+			t.Errorf("From(%v).BatchWhile()=%v expected %v", test.input, r, test.output)
+		}
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		pageSize int
+		output   []interface{}
+	}{
+		{[]int{1, 2, 3, 4, 5}, 2, []interface{}{
+			[]interface{}{1, 2}, []interface{}{3, 4}, []interface{}{5},
+		}},
+		{[]int{1, 2}, 5, []interface{}{[]interface{}{1, 2}}},
+		{[]int{1, 2}, 0, nil},
+		{[]int{}, 2, nil},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).Paginate(test.pageSize).Results(); !reflect.DeepEqual(r, test.output) {
+			t.Errorf("From(%v).Paginate(%d)=%v expected %v", test.input, test.pageSize, r, test.output)
+		}
+	}
+}
+
+func TestPage(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6, 7}
+
+	tests := []struct {
+		pageNumber int
+		pageSize   int
+		output     []interface{}
+	}{
+		{1, 3, []interface{}{1, 2, 3}},
+		{3, 3, []interface{}{7}},
+		{4, 3, []interface{}{}},
+		{0, 3, []interface{}{}},
+		{1, 0, []interface{}{}},
+		{maxInt, 2, []interface{}{}},
+		{1, maxInt, []interface{}{1, 2, 3, 4, 5, 6, 7}},
+		{2, maxInt, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(input).Page(test.pageNumber, test.pageSize); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).Page(%d, %d)=%v expected %v", input, test.pageNumber, test.pageSize, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestChunkByWeight(t *testing.T) {
+	length := func(item interface{}) (float64, error) {
+		return float64(len(item.(string))), nil
+	}
+
+	tests := []struct {
+		input     interface{}
+		maxWeight float64
+		output    []interface{}
+	}{
+		{[]string{"ab", "cde", "f", "ghij", "k"}, 5, []interface{}{
+			[]interface{}{"ab", "cde"}, []interface{}{"f", "ghij"}, []interface{}{"k"},
+		}},
+		{[]string{"a", "bcdefg", "h"}, 3, []interface{}{
+			[]interface{}{"a"}, []interface{}{"bcdefg"}, []interface{}{"h"},
+		}},
+		{[]string{}, 3, nil},
+	}
+
+	for _, test := range tests {
+		q, err := From(test.input).ChunkByWeight(test.maxWeight, length)
+		if err != nil {
+			t.Fatalf("From(%v).ChunkByWeight(%v) returned error %v", test.input, test.maxWeight, err)
+		}
+
+		if r := q.Results(); !reflect.DeepEqual(r, test.output) {
+			t.Errorf("From(%v).ChunkByWeight(%v)=%v expected %v", test.input, test.maxWeight, r, test.output)
+		}
+	}
+
+	errWeight := errors.New("unknown size")
+	_, err := From([]interface{}{"a", 1, "b"}).ChunkByWeight(5, func(item interface{}) (float64, error) {
+		s, ok := item.(string)
+		if !ok {
+			return 0, errWeight
+		}
+
+		return float64(len(s)), nil
+	})
+
+	if err != errWeight {
+		t.Errorf("ChunkByWeight() error=%v expected %v", err, errWeight)
+	}
+}
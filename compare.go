@@ -1,5 +1,7 @@
 package linq
 
+import "time"
+
 type comparer func(interface{}, interface{}) int
 
 // Comparable is an interface that has to be implemented by a custom collection
@@ -191,6 +193,18 @@ func getComparer(data interface{}) comparer {
 				return -1
 			}
 		}
+	case time.Time:
+		return func(x, y interface{}) int {
+			a, b := x.(time.Time), y.(time.Time)
+			switch {
+			case a.After(b):
+				return 1
+			case b.After(a):
+				return -1
+			default:
+				return 0
+			}
+		}
 	default:
 		return func(x, y interface{}) int {
 			a, b := x.(Comparable), y.(Comparable)
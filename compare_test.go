@@ -1,6 +1,9 @@
 package linq
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestGetComparer(t *testing.T) {
 	tests := []struct {
@@ -55,6 +58,9 @@ func TestGetComparer(t *testing.T) {
 		{foo{f1: 1}, foo{f1: 5}, -1},
 		{foo{f1: 5}, foo{f1: 1}, 1},
 		{foo{f1: 1}, foo{f1: 1}, 0},
+		{time.Unix(5, 0), time.Unix(1, 0), 1},
+		{time.Unix(1, 0), time.Unix(5, 0), -1},
+		{time.Unix(1, 0), time.Unix(1, 0).UTC(), 0},
 	}
 
 	for _, test := range tests {
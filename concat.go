@@ -53,6 +53,110 @@ func (q Query) Concat(q2 Query) Query {
 	}
 }
 
+// InsertAt inserts an item into a collection at the specified zero-based
+// index, shifting the elements at and after that index. If index is equal to
+// the number of elements, the item is appended to the end of the collection.
+// If index is negative or greater than the number of elements, the item is not
+// inserted.
+func (q Query) InsertAt(index int, item interface{}) Query {
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			current := 0
+			inserted := false
+
+			return func() (interface{}, bool) {
+				if !inserted && current == index {
+					inserted = true
+					return item, true
+				}
+
+				i, ok := next()
+				if ok {
+					current++
+				}
+
+				return i, ok
+			}
+		},
+	}
+}
+
+// Interleave merges two collections by alternating their elements: the first
+// element of the first collection, then the first element of the second
+// collection, and so on. When one of the collections is exhausted, the
+// remaining elements of the other one are returned.
+func (q Query) Interleave(q2 Query) Query {
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			next2 := q2.Iterate()
+			done, done2 := false, false
+			second := false
+
+			return func() (item interface{}, ok bool) {
+				for !done || !done2 {
+					if second {
+						second = false
+						if !done2 {
+							if item, ok = next2(); ok {
+								return
+							}
+							done2 = true
+						}
+
+						continue
+					}
+
+					second = true
+					if !done {
+						if item, ok = next(); ok {
+							return
+						}
+						done = true
+					}
+				}
+
+				return nil, false
+			}
+		},
+	}
+}
+
+// Intersperse inserts a separator between each pair of adjacent elements of a
+// collection, so [a, b, c] becomes [a, sep, b, sep, c]. No separator is added
+// before the first or after the last element.
+func (q Query) Intersperse(sep interface{}) Query {
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			started := false
+			var pending interface{}
+			hasPending := false
+
+			return func() (interface{}, bool) {
+				if hasPending {
+					hasPending = false
+					return pending, true
+				}
+
+				i, ok := next()
+				if !ok {
+					return nil, false
+				}
+
+				if !started {
+					started = true
+					return i, true
+				}
+
+				pending, hasPending = i, true
+				return sep, true
+			}
+		},
+	}
+}
+
 // Prepend inserts an item to the beginning of a collection, so it becomes the
 // first item.
 func (q Query) Prepend(item interface{}) Query {
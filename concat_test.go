@@ -21,6 +21,64 @@ func TestConcat(t *testing.T) {
 	}
 }
 
+func TestInsertAt(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		index  int
+		output []interface{}
+	}{
+		{[]int{1, 2, 3}, 0, []interface{}{0, 1, 2, 3}},
+		{[]int{1, 2, 3}, 2, []interface{}{1, 2, 0, 3}},
+		{[]int{1, 2, 3}, 3, []interface{}{1, 2, 3, 0}},
+		{[]int{1, 2, 3}, 4, []interface{}{1, 2, 3}},
+		{[]int{1, 2, 3}, -1, []interface{}{1, 2, 3}},
+		{[]int{}, 0, []interface{}{0}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).InsertAt(test.index, 0); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).InsertAt(%v)=%v expected %v", test.input, test.index, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		input2 interface{}
+		output []interface{}
+	}{
+		{[]int{1, 3, 5}, []int{2, 4, 6}, []interface{}{1, 2, 3, 4, 5, 6}},
+		{[]int{1, 3, 5, 7, 8}, []int{2, 4}, []interface{}{1, 2, 3, 4, 5, 7, 8}},
+		{[]int{1}, []int{2, 3, 4}, []interface{}{1, 2, 3, 4}},
+		{[]int{}, []int{2, 3}, []interface{}{2, 3}},
+		{[]int{}, []int{}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).Interleave(From(test.input2)); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).Interleave(%v)=%v expected %v", test.input, test.input2, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestIntersperse(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		output []interface{}
+	}{
+		{[]string{"a", "b", "c"}, []interface{}{"a", ",", "b", ",", "c"}},
+		{[]string{"a"}, []interface{}{"a"}},
+		{[]string{}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).Intersperse(","); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).Intersperse()=%v expected %v", test.input, toSlice(q), test.output)
+		}
+	}
+}
+
 func TestPrepend(t *testing.T) {
 	input := []int{1, 2, 3, 4}
 	want := []interface{}{0, 1, 2, 3, 4}
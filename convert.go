@@ -1,5 +1,10 @@
 package linq
 
+import (
+	"fmt"
+	"math"
+)
+
 type intConverter func(interface{}) int64
 
 func getIntConverter(data interface{}) intConverter {
@@ -68,3 +73,112 @@ func getFloatConverter(data interface{}) floatConverter {
 		return i.(float64)
 	}
 }
+
+// toFloat64 converts any numeric value to float64. The second result reports
+// whether data is of a numeric type.
+func toFloat64(data interface{}) (float64, bool) {
+	switch v := data.(type) {
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+
+	return 0, false
+}
+
+// AsFloat64s converts every element of a collection to float64. Elements of any
+// numeric type are converted. It is useful to normalize a collection with mixed
+// numeric types before a numeric operation.
+//
+// AsFloat64s panics with an error wrapping ErrTypeMismatch when it reaches an
+// element that is not numeric.
+//
+// Like any query, the conversion runs again on every enumeration. When several
+// aggregates are computed over the same values, call Cache on the result so
+// that the values are converted only once.
+func (q Query) AsFloat64s() Query {
+	return q.Select(func(item interface{}) interface{} {
+		f, ok := toFloat64(item)
+		if !ok {
+			panic(fmt.Errorf("AsFloat64s: %w: %v is a %T", ErrTypeMismatch, item, item))
+		}
+
+		return f
+	})
+}
+
+// maxInt and minInt are the largest and the smallest values of type int.
+const (
+	maxInt = int(^uint(0) >> 1)
+	minInt = -maxInt - 1
+)
+
+// AsInts converts every element of a collection to int. Elements of any
+// integer type are converted, and floating-point elements are truncated toward
+// zero.
+//
+// AsInts panics with an error wrapping ErrTypeMismatch when it reaches an
+// element that is not numeric, and with an error wrapping ErrOutOfRange when
+// it reaches a NaN, an infinity or a value that doesn't fit in an int.
+func (q Query) AsInts() Query {
+	return q.Select(func(item interface{}) interface{} {
+		switch item.(type) {
+		case int, int8, int16, int32, int64:
+			v := getIntConverter(item)(item)
+			if v < int64(minInt) || v > int64(maxInt) {
+				panic(fmt.Errorf("AsInts: %w: %v doesn't fit in an int", ErrOutOfRange, item))
+			}
+
+			return int(v)
+		case uint, uint8, uint16, uint32, uint64:
+			v := getUIntConverter(item)(item)
+			if v > uint64(maxInt) {
+				panic(fmt.Errorf("AsInts: %w: %v doesn't fit in an int", ErrOutOfRange, item))
+			}
+
+			return int(v)
+		case float32, float64:
+			v := math.Trunc(getFloatConverter(item)(item))
+			if math.IsNaN(v) || v < float64(minInt) || v >= -float64(minInt) {
+				panic(fmt.Errorf("AsInts: %w: %v doesn't fit in an int", ErrOutOfRange, item))
+			}
+
+			return int(v)
+		}
+
+		panic(fmt.Errorf("AsInts: %w: %v is a %T", ErrTypeMismatch, item, item))
+	})
+}
+
+// AsStrings converts every element of a collection to its string
+// representation, as formatted by fmt.Sprint.
+func (q Query) AsStrings() Query {
+	return q.Select(func(item interface{}) interface{} {
+		if s, ok := item.(string); ok {
+			return s
+		}
+
+		return fmt.Sprint(item)
+	})
+}
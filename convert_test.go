@@ -1,6 +1,9 @@
 package linq
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func TestIntConverter(t *testing.T) {
 	tests := []struct {
@@ -55,3 +58,117 @@ func TestFloatConverter(t *testing.T) {
 		}
 	}
 }
+
+func TestToFloat64(t *testing.T) {
+	tests := []struct {
+		input   interface{}
+		want    float64
+		numeric bool
+	}{
+		{int(-1), -1, true},
+		{int8(2), 2, true},
+		{int16(3), 3, true},
+		{int32(4), 4, true},
+		{int64(5), 5, true},
+		{uint(6), 6, true},
+		{uint8(7), 7, true},
+		{uint16(8), 8, true},
+		{uint32(9), 9, true},
+		{uint64(10), 10, true},
+		{float32(0.5), 0.5, true},
+		{1.5, 1.5, true},
+		{"1", 0, false},
+		{nil, 0, false},
+	}
+
+	for _, test := range tests {
+		if r, numeric := toFloat64(test.input); r != test.want || numeric != test.numeric {
+			t.Errorf("toFloat64(%v)=%v,%v expected %v,%v", test.input, r, numeric, test.want, test.numeric)
+		}
+	}
+}
+
+func TestAsFloat64s(t *testing.T) {
+	input := []interface{}{1, int64(2), uint8(3), float32(0.5), 1.25}
+	want := []interface{}{1.0, 2.0, 3.0, 0.5, 1.25}
+
+	if q := From(input).AsFloat64s(); !validateQuery(q, want) {
+		t.Errorf("From(%v).AsFloat64s()=%v expected %v", input, toSlice(q), want)
+	}
+
+	if r := From([]interface{}{math.NaN()}).AsFloat64s().First().(float64); !math.IsNaN(r) {
+		t.Errorf("AsFloat64s()=%v expected NaN", r)
+	}
+}
+
+func TestAsFloat64s_PanicWhenElementIsNotNumeric(t *testing.T) {
+	mustPanicWithError(t, "AsFloat64s: element type mismatch: a is a string", func() {
+		From([]interface{}{1, "a"}).AsFloat64s().Results()
+	})
+}
+
+func TestAsFloat64s_Cache(t *testing.T) {
+	conversions := 0
+	values := From([]int{1, 2, 3}).Tap(func(interface{}) {
+		conversions++
+	}).AsFloat64s().Cache()
+
+	if r := values.SumFloats(); r != 6 {
+		t.Errorf("SumFloats()=%v expected 6", r)
+	}
+
+	if r := values.Average(); r != 2 {
+		t.Errorf("Average()=%v expected 2", r)
+	}
+
+	if conversions != 3 {
+		t.Errorf("AsFloat64s().Cache() converted %d values expected 3", conversions)
+	}
+}
+
+func TestAsInts(t *testing.T) {
+	input := []interface{}{1, int8(-2), uint16(3), 4.9, float32(-5.5)}
+	want := []interface{}{1, -2, 3, 4, -5}
+
+	if q := From(input).AsInts(); !validateQuery(q, want) {
+		t.Errorf("From(%v).AsInts()=%v expected %v", input, toSlice(q), want)
+	}
+}
+
+func TestAsInts_PanicWhenElementIsNotNumeric(t *testing.T) {
+	mustPanicWithError(t, "AsInts: element type mismatch: a is a string", func() {
+		From([]interface{}{1, "a"}).AsInts().Results()
+	})
+
+	mustPanicWithError(t, "AsInts: element type mismatch: <nil> is a <nil>", func() {
+		From([]interface{}{nil}).AsInts().Results()
+	})
+}
+
+func TestAsInts_PanicWhenElementIsOutOfRange(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  string
+	}{
+		{math.NaN(), "AsInts: value out of range: NaN doesn't fit in an int"},
+		{math.Inf(1), "AsInts: value out of range: +Inf doesn't fit in an int"},
+		{float32(math.Inf(-1)), "AsInts: value out of range: -Inf doesn't fit in an int"},
+		{1e300, "AsInts: value out of range: 1e+300 doesn't fit in an int"},
+		{uint64(math.MaxUint64), "AsInts: value out of range: 18446744073709551615 doesn't fit in an int"},
+	}
+
+	for _, test := range tests {
+		mustPanicWithError(t, test.want, func() {
+			From([]interface{}{test.input}).AsInts().Results()
+		})
+	}
+}
+
+func TestAsStrings(t *testing.T) {
+	input := []interface{}{"a", 1, 2.5, true}
+	want := []interface{}{"a", "1", "2.5", "true"}
+
+	if q := From(input).AsStrings(); !validateQuery(q, want) {
+		t.Errorf("From(%v).AsStrings()=%v expected %v", input, toSlice(q), want)
+	}
+}
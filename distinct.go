@@ -96,3 +96,293 @@ func (q Query) DistinctByT(selectorFn interface{}) Query {
 	}
 	return q.DistinctBy(selectorFunc)
 }
+
+// DistinctByHashed method returns distinct elements from a collection, using
+// a custom equality function. Elements are first grouped by the key returned
+// by hash, and equal is only invoked to compare elements with the same key,
+// so hash must return the same key for any two elements that are equal.
+//
+// The result is an unordered collection that contains no duplicate values.
+func (q Query) DistinctByHashed(hash func(interface{}) interface{},
+	equal func(interface{}, interface{}) bool) Query {
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			buckets := make(map[interface{}][]interface{})
+
+			return func() (item interface{}, ok bool) {
+			outer:
+				for item, ok = next(); ok; item, ok = next() {
+					h := hash(item)
+					for _, seen := range buckets[h] {
+						if equal(seen, item) {
+							continue outer
+						}
+					}
+
+					buckets[h] = append(buckets[h], item)
+					return
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// DistinctByHashedT is the typed version of DistinctByHashed.
+//
+//   - hashFn is of type "func(TSource)TKey"
+//   - equalFn is of type "func(TSource,TSource)bool"
+//
+// NOTE: DistinctByHashed has better performance than DistinctByHashedT.
+func (q Query) DistinctByHashedT(hashFn interface{}, equalFn interface{}) Query {
+	hashGenericFunc, err := newGenericFunc(
+		"DistinctByHashedT", "hashFn", hashFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	hashFunc := func(item interface{}) interface{} {
+		return hashGenericFunc.Call(item)
+	}
+
+	equalGenericFunc, err := newGenericFunc(
+		"DistinctByHashedT", "equalFn", equalFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType), new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	equalFunc := func(a interface{}, b interface{}) bool {
+		return equalGenericFunc.Call(a, b).(bool)
+	}
+
+	return q.DistinctByHashed(hashFunc, equalFunc)
+}
+
+// CountDistinct returns the number of distinct elements in a collection.
+//
+// CountDistinct only keeps track of the elements it has seen, so it is cheaper
+// than counting the result of Distinct.
+func (q Query) CountDistinct() int {
+	next := q.Iterate()
+	set := make(map[interface{}]struct{})
+
+	for item, ok := next(); ok; item, ok = next() {
+		set[item] = struct{}{}
+	}
+
+	return len(set)
+}
+
+// CountDistinctBy returns the number of elements of a collection with distinct
+// keys. This method executes selector function for each element to determine
+// a value to compare.
+func (q Query) CountDistinctBy(selector func(interface{}) interface{}) int {
+	next := q.Iterate()
+	set := make(map[interface{}]struct{})
+
+	for item, ok := next(); ok; item, ok = next() {
+		set[selector(item)] = struct{}{}
+	}
+
+	return len(set)
+}
+
+// CountDistinctByT is the typed version of CountDistinctBy.
+//
+//   - selectorFn is of type "func(TSource) TSource".
+//
+// NOTE: CountDistinctBy has better performance than CountDistinctByT.
+func (q Query) CountDistinctByT(selectorFn interface{}) int {
+	selectorGenericFunc, err := newGenericFunc(
+		"CountDistinctByT", "selectorFn", selectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	selectorFunc := func(item interface{}) interface{} {
+		return selectorGenericFunc.Call(item)
+	}
+
+	return q.CountDistinctBy(selectorFunc)
+}
+
+// DistinctUntilChanged method returns the elements of a collection, skipping
+// each element that is equal to the element immediately preceding it. This
+// collapses runs of consecutive duplicates, while non-adjacent duplicates are
+// kept. The first element is always returned.
+func (q Query) DistinctUntilChanged() Query {
+	return q.DistinctUntilChangedBy(func(item interface{}) interface{} {
+		return item
+	})
+}
+
+// DistinctUntilChangedBy method returns the elements of a collection, skipping
+// each element whose key is equal to the key of the element immediately
+// preceding it. This method executes selector function for each element to
+// determine a value to compare. The first element is always returned.
+func (q Query) DistinctUntilChangedBy(selector func(interface{}) interface{}) Query {
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			started := false
+			var prev interface{}
+
+			return func() (item interface{}, ok bool) {
+				for item, ok = next(); ok; item, ok = next() {
+					s := selector(item)
+					if !started || s != prev {
+						started = true
+						prev = s
+						return
+					}
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// DistinctUntilChangedByT is the typed version of DistinctUntilChangedBy.
+//
+//   - selectorFn is of type "func(TSource) TSource".
+//
+// NOTE: DistinctUntilChangedBy has better performance than
+// DistinctUntilChangedByT.
+func (q Query) DistinctUntilChangedByT(selectorFn interface{}) Query {
+	selectorGenericFunc, err := newGenericFunc(
+		"DistinctUntilChangedByT", "selectorFn", selectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	selectorFunc := func(item interface{}) interface{} {
+		return selectorGenericFunc.Call(item)
+	}
+
+	return q.DistinctUntilChangedBy(selectorFunc)
+}
+
+// DistinctRecent method returns the elements of a collection, skipping an
+// element if it is equal to one of the last window returned elements. Unlike
+// Distinct, it only keeps track of window elements, so its memory usage is
+// bounded, and an element can be returned again once it is out of the window.
+//
+// If window is not positive, all the elements are returned.
+func (q Query) DistinctRecent(window int) Query {
+	if window <= 0 {
+		return q
+	}
+
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			var recent []interface{}
+			counts := make(map[interface{}]int)
+			oldest := 0
+
+			return func() (item interface{}, ok bool) {
+				for item, ok = next(); ok; item, ok = next() {
+					if counts[item] > 0 {
+						continue
+					}
+
+					if len(recent) < window {
+						recent = append(recent, item)
+					} else {
+						if counts[recent[oldest]]--; counts[recent[oldest]] == 0 {
+							delete(counts, recent[oldest])
+						}
+
+						recent[oldest] = item
+						oldest = (oldest + 1) % window
+					}
+
+					counts[item]++
+					return
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// Duplicates method returns the elements that occur more than once in a
+// collection. Each repeated element is returned once, in the order of its
+// first appearance. It is the complement of Distinct.
+func (q Query) Duplicates() Query {
+	return q.DuplicatesBy(func(item interface{}) interface{} { return item })
+}
+
+// DuplicatesBy method returns the elements of a collection whose key occurs
+// more than once. This method executes selector function for each element to
+// determine its key. For each repeated key, the first element with that key is
+// returned, in the order of its first appearance.
+func (q Query) DuplicatesBy(selector func(interface{}) interface{}) Query {
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			var keys []interface{}
+			var firsts []interface{}
+			counts := make(map[interface{}]int)
+
+			for item, ok := next(); ok; item, ok = next() {
+				key := selector(item)
+				if counts[key] == 0 {
+					keys = append(keys, key)
+					firsts = append(firsts, item)
+				}
+
+				counts[key]++
+			}
+
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				for ; index < len(keys); index++ {
+					if counts[keys[index]] > 1 {
+						item, ok = firsts[index], true
+						index++
+						return
+					}
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// DuplicatesByT is the typed version of DuplicatesBy.
+//
+//   - selectorFn is of type "func(TSource) TSource".
+//
+// NOTE: DuplicatesBy has better performance than DuplicatesByT.
+func (q Query) DuplicatesByT(selectorFn interface{}) Query {
+	selectorFunc, ok := selectorFn.(func(interface{}) interface{})
+	if !ok {
+		selectorGenericFunc, err := newGenericFunc(
+			"DuplicatesByT", "selectorFn", selectorFn,
+			simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		selectorFunc = func(item interface{}) interface{} {
+			return selectorGenericFunc.Call(item)
+		}
+	}
+	return q.DuplicatesBy(selectorFunc)
+}
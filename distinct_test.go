@@ -1,6 +1,9 @@
 package linq
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestDistinct(t *testing.T) {
 	tests := []struct {
@@ -59,3 +62,171 @@ func TestDistinctByT_PanicWhenSelectorFnIsInvalid(t *testing.T) {
 		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).DistinctByT(func(indice, item string) bool { return item == "2" })
 	})
 }
+
+func TestDistinctByHashed(t *testing.T) {
+	input := []string{"Go", "go", "Rust", "GO", "rust", "C"}
+	want := []interface{}{"Go", "Rust", "C"}
+	comparisons := 0
+
+	q := From(input).DistinctByHashed(func(i interface{}) interface{} {
+		return len(i.(string))
+	}, func(a, b interface{}) bool {
+		comparisons++
+		return strings.EqualFold(a.(string), b.(string))
+	})
+
+	if !validateQuery(q, want) {
+		t.Errorf("From(%v).DistinctByHashed()=%v expected %v", input, toSlice(q), want)
+	}
+
+	if comparisons != 3 {
+		t.Errorf("DistinctByHashed() compared %d times expected 3", comparisons)
+	}
+}
+
+func TestDistinctByHashedT_PanicWhenHashFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "DistinctByHashedT: parameter [hashFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(string,string)bool'", func() {
+		From([]string{"a"}).DistinctByHashedT(func(a, b string) bool { return a == b }, func(a, b string) bool { return a == b })
+	})
+}
+
+func TestDistinctByHashedT_PanicWhenEqualFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "DistinctByHashedT: parameter [equalFn] has a invalid function signature. Expected: 'func(T,T)bool', actual: 'func(string)int'", func() {
+		From([]string{"a"}).DistinctByHashedT(func(s string) int { return len(s) }, func(s string) int { return len(s) })
+	})
+}
+
+func TestCountDistinct(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  int
+	}{
+		{[]int{1, 2, 2, 3, 1}, 3},
+		{[9]int{1, 1, 1, 2, 1, 2, 3, 4, 2}, 4},
+		{"sstr", 3},
+		{[]int{}, 0},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).CountDistinct(); r != test.want {
+			t.Errorf("From(%v).CountDistinct()=%v expected %v", test.input, r, test.want)
+		}
+	}
+}
+
+func TestCountDistinctBy(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+
+	users := []user{{1, "Foo"}, {2, "Bar"}, {3, "Foo"}}
+
+	if r := From(users).CountDistinctBy(func(u interface{}) interface{} {
+		return u.(user).name
+	}); r != 2 {
+		t.Errorf("From(%v).CountDistinctBy()=%v expected 2", users, r)
+	}
+}
+
+func TestCountDistinctByT_PanicWhenSelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "CountDistinctByT: parameter [selectorFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(string,string)bool'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).CountDistinctByT(func(indice, item string) bool { return item == "2" })
+	})
+}
+
+func TestDistinctUntilChanged(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		output []interface{}
+	}{
+		{[]int{1, 1, 2, 2, 2, 1, 3, 3}, []interface{}{1, 2, 1, 3}},
+		{[]interface{}{nil, nil, 1}, []interface{}{nil, 1}},
+		{"sstrr", []interface{}{'s', 't', 'r'}},
+		{[]int{}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).DistinctUntilChanged(); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).DistinctUntilChanged()=%v expected %v", test.input, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestDistinctUntilChangedBy(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+
+	users := []user{{1, "Foo"}, {2, "Foo"}, {3, "Bar"}, {4, "Foo"}}
+	want := []interface{}{user{1, "Foo"}, user{3, "Bar"}, user{4, "Foo"}}
+
+	if q := From(users).DistinctUntilChangedBy(func(u interface{}) interface{} {
+		return u.(user).name
+	}); !validateQuery(q, want) {
+		t.Errorf("From(%v).DistinctUntilChangedBy()=%v expected %v", users, toSlice(q), want)
+	}
+}
+
+func TestDistinctUntilChangedByT_PanicWhenSelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "DistinctUntilChangedByT: parameter [selectorFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(string,string)bool'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).DistinctUntilChangedByT(func(indice, item string) bool { return item == "2" })
+	})
+}
+
+func TestDistinctRecent(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		window int
+		output []interface{}
+	}{
+		{[]int{1, 2, 1, 3, 1, 2, 2, 4, 1}, 2, []interface{}{1, 2, 3, 1, 2, 4, 1}},
+		{[]int{1, 2, 1, 3, 1, 2, 2, 4, 1}, 3, []interface{}{1, 2, 3, 4, 1}},
+		{[]int{1, 1, 2, 2, 1}, 1, []interface{}{1, 2, 1}},
+		{[]int{1, 2, 1, 3, 2}, maxInt, []interface{}{1, 2, 3}},
+		{[]int{1, 1, 2}, 0, []interface{}{1, 1, 2}},
+		{[]int{1, 1, 2}, -1, []interface{}{1, 1, 2}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).DistinctRecent(test.window); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).DistinctRecent(%d)=%v expected %v", test.input, test.window, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestDuplicates(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		output []interface{}
+	}{
+		{[]int{3, 1, 2, 1, 3, 3, 4}, []interface{}{3, 1}},
+		{[]int{1, 2, 3}, []interface{}{}},
+		{"sstring", []interface{}{'s'}},
+		{[]int{}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).Duplicates(); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).Duplicates()=%v expected %v", test.input, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestDuplicatesBy(t *testing.T) {
+	input := []string{"apple", "banana", "avocado", "cherry", "blueberry", "apricot"}
+	want := []interface{}{"apple", "banana"}
+
+	if q := From(input).DuplicatesByT(func(s string) byte {
+		return s[0]
+	}); !validateQuery(q, want) {
+		t.Errorf("From(%v).DuplicatesBy()=%v expected %v", input, toSlice(q), want)
+	}
+}
+
+func TestDuplicatesByT_PanicWhenSelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "DuplicatesByT: parameter [selectorFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(string,string)bool'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).DuplicatesByT(func(indice, item string) bool { return item == "2" })
+	})
+}
@@ -0,0 +1,27 @@
+package linq
+
+import "errors"
+
+// ErrTypeMismatch is returned when an element of a collection is not of the
+// type a method expects.
+var ErrTypeMismatch = errors.New("element type mismatch")
+
+// ErrEmptySequence is returned when a method needs at least one element and
+// the collection is empty.
+var ErrEmptySequence = errors.New("empty sequence")
+
+// ErrTimeout is returned when an operation doesn't complete within its
+// deadline.
+var ErrTimeout = errors.New("timeout")
+
+// ErrNoElement is returned when no element of a collection satisfies a
+// condition.
+var ErrNoElement = errors.New("no element satisfies the condition")
+
+// ErrMultipleElements is returned when more than one element of a collection
+// satisfies a condition that only one element is expected to satisfy.
+var ErrMultipleElements = errors.New("more than one element satisfies the condition")
+
+// ErrOutOfRange is returned when a value can't be represented in the type a
+// method converts it to, such as a NaN or a too large number converted to int.
+var ErrOutOfRange = errors.New("value out of range")
@@ -665,8 +665,8 @@ func ExampleOrderedQuery_ThenByDescending() {
 	}
 	// Output:
 	// apPLe
-	// apPLE
 	// apple
+	// apPLE
 	// APple
 	// orange
 	// baNanA
@@ -2483,3 +2483,242 @@ func ExampleQuery_ZipT() {
 	// Output:
 	// [[1 one] [2 two] [3 three]]
 }
+
+// The following code example demonstrates how to use Window
+// to compute the sum of every three consecutive numbers.
+func ExampleQuery_Window() {
+	numbers := []int{1, 2, 3, 4, 5, 6}
+
+	sums := From(numbers).
+		Window(3).
+		Select(func(w interface{}) interface{} {
+			return From(w).SumInts()
+		}).
+		Results()
+
+	fmt.Println(sums)
+	// Output:
+	// [6 9 12 15]
+}
+
+// The following code example demonstrates how to use Partition
+// to split a slice into passing and failing grades in a single pass.
+func ExampleQuery_Partition() {
+	grades := []int{59, 82, 70, 56, 92, 98, 85}
+
+	passed, failed := From(grades).Partition(func(g interface{}) bool {
+		return g.(int) >= 60
+	})
+
+	fmt.Println(passed.Results())
+	fmt.Println(failed.Results())
+	// Output:
+	// [82 70 92 98 85]
+	// [59 56]
+}
+
+// The following code example demonstrates how to use ToLookupT
+// to index a slice of words by their first letter.
+func ExampleQuery_ToLookupT() {
+	words := []string{"apple", "banana", "avocado", "blueberry", "cherry"}
+
+	lookup := From(words).ToLookupT(func(w string) string { return w[:1] })
+
+	fmt.Println(lookup["a"])
+	fmt.Println(lookup["b"])
+	fmt.Println(lookup["c"])
+	// Output:
+	// [apple avocado]
+	// [banana blueberry]
+	// [cherry]
+}
+
+// The following code example demonstrates how to use OrderByKeyT
+// to sort words by their length using a custom key comparison.
+func ExampleQuery_OrderByKeyT() {
+	words := []string{"elephant", "cat", "horse", "ox"}
+
+	var query []string
+	From(words).
+		OrderByKeyT(
+			func(word string) int { return len(word) },
+			func(a, b int) bool { return a < b },
+		).
+		ToSlice(&query)
+
+	fmt.Println(query)
+	// Output:
+	// [ox cat horse elephant]
+}
+
+// The following code example demonstrates how to use SortStableT
+// to sort words by length while keeping words of equal length
+// in their original order.
+func ExampleQuery_SortStableT() {
+	words := []string{"pear", "fig", "plum", "kiwi", "date", "lime"}
+
+	var query []string
+	From(words).
+		SortStableT(
+			func(a, b string) bool { return len(a) < len(b) },
+		).
+		ToSlice(&query)
+
+	fmt.Println(query)
+	// Output:
+	// [fig pear plum kiwi date lime]
+}
+
+// The following code example demonstrates how to use Flatten
+// to merge nested slices into a single slice.
+func ExampleQuery_Flatten() {
+	orders := []interface{}{
+		[]string{"apple", "banana"},
+		"cherry",
+		[]string{"date"},
+	}
+
+	fmt.Println(From(orders).Flatten().Results())
+	// Output:
+	// [apple banana cherry date]
+}
+
+// The following code example demonstrates how to use Cache
+// to avoid running an expensive projection more than once.
+func ExampleQuery_Cache() {
+	squares := Range(1, 5).
+		Select(func(i interface{}) interface{} {
+			fmt.Println("computing", i)
+			return i.(int) * i.(int)
+		}).
+		Cache()
+
+	fmt.Println(squares.SumInts())
+	fmt.Println(squares.Max())
+	// Output:
+	// computing 1
+	// computing 2
+	// computing 3
+	// computing 4
+	// computing 5
+	// 55
+	// 25
+}
+
+// The following code example demonstrates how to use FromJSON and ToJSON
+// to query a JSON document.
+func ExampleFromJSON() {
+	q, err := FromJSON([]byte(`[{"name": "apple", "price": 3}, {"name": "kiwi", "price": 1}]`))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	cheap, _ := q.
+		Where(func(i interface{}) bool {
+			return i.(map[string]interface{})["price"].(float64) < 2
+		}).
+		Select(func(i interface{}) interface{} {
+			return i.(map[string]interface{})["name"]
+		}).
+		ToJSON()
+
+	fmt.Println(string(cheap))
+	// Output:
+	// ["kiwi"]
+}
+
+// The following code example demonstrates how to use ForEachChunk
+// to process a collection in batches.
+func ExampleQuery_ForEachChunk() {
+	err := Range(1, 7).ForEachChunk(3, func(batch []interface{}) error {
+		fmt.Println("inserting", batch)
+		return nil
+	})
+
+	fmt.Println(err)
+	// Output:
+	// inserting [1 2 3]
+	// inserting [4 5 6]
+	// inserting [7]
+	// <nil>
+}
+
+// The following code example demonstrates how to use GroupAdjacentT
+// to collapse runs of identical statuses in an event stream.
+func ExampleQuery_GroupAdjacentT() {
+	events := []string{"up", "up", "down", "down", "down", "up"}
+
+	From(events).
+		GroupAdjacentT(func(status string) string { return status }).
+		ForEachT(func(run Group) {
+			fmt.Println(run.Key, len(run.Group))
+		})
+	// Output:
+	// up 2
+	// down 3
+	// up 1
+}
+
+// The following code example demonstrates how to use FrequenciesByT
+// to build a histogram of word lengths.
+func ExampleQuery_FrequenciesByT() {
+	words := []string{"go", "linq", "is", "fun", "and", "lazy"}
+
+	histogram := From(words).FrequenciesByT(func(w string) int { return len(w) })
+
+	for length := 2; length <= 4; length++ {
+		fmt.Println(length, histogram[length])
+	}
+	// Output:
+	// 2 2
+	// 3 2
+	// 4 2
+}
+
+// The following code example demonstrates how to use JoinString
+// to build a comma separated list from projected values.
+func ExampleQuery_JoinString() {
+	names, err := From([]int{3, 1, 2}).
+		Sort(func(i, j interface{}) bool { return i.(int) < j.(int) }).
+		Select(func(i interface{}) interface{} { return fmt.Sprintf("item-%d", i) }).
+		JoinString(", ")
+
+	fmt.Println(names, err)
+	// Output:
+	// item-1, item-2, item-3 <nil>
+}
+
+// The following code example demonstrates how to use Enumerate
+// to keep track of the original positions of the elements that pass a filter.
+func ExampleQuery_Enumerate() {
+	scores := []int{72, 95, 60, 88}
+
+	From(scores).
+		Enumerate().
+		Where(func(pair interface{}) bool {
+			return pair.(KeyValue).Value.(int) >= 80
+		}).
+		ForEach(func(pair interface{}) {
+			fmt.Printf("score #%d: %d\n", pair.(KeyValue).Key, pair.(KeyValue).Value)
+		})
+	// Output:
+	// score #1: 95
+	// score #3: 88
+}
+
+// The following code example demonstrates how to use AsFloat64s with Cache
+// to convert mixed numeric values once and compute several statistics.
+func ExampleQuery_AsFloat64s() {
+	readings := []interface{}{12, int64(15), 9.5, uint8(11)}
+
+	values := From(readings).AsFloat64s().Cache()
+
+	fmt.Println(values.SumFloats())
+	fmt.Println(values.Average())
+	fmt.Println(values.Max())
+	// Output:
+	// 47.5
+	// 11.875
+	// 15
+}
@@ -77,3 +77,29 @@ func (q Query) ExceptByT(q2 Query,
 
 	return q.ExceptBy(q2, selectorFunc)
 }
+
+// ExceptAll produces the multiset difference of two sequences. Unlike Except,
+// every occurrence of an element in the second sequence removes a single
+// occurrence of that element from the first sequence, so [1, 1, 2] except [1]
+// is [1, 2]. The order of the first sequence is preserved.
+func (q Query) ExceptAll(q2 Query) Query {
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			counts := q2.Frequencies()
+
+			return func() (item interface{}, ok bool) {
+				for item, ok = next(); ok; item, ok = next() {
+					if counts[item] > 0 {
+						counts[item]--
+						continue
+					}
+
+					return
+				}
+
+				return
+			}
+		},
+	}
+}
@@ -29,3 +29,13 @@ func TestExceptByT_PanicWhenSelectorFnIsInvalid(t *testing.T) {
 		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).ExceptByT(From([]int{1}), func(x, item int) int { return item + 2 })
 	})
 }
+
+func TestExceptAll(t *testing.T) {
+	input1 := []int{1, 2, 3, 4, 5, 1, 2, 5}
+	input2 := []int{1, 5, 5, 5, 6}
+	want := []interface{}{2, 3, 4, 1, 2}
+
+	if q := From(input1).ExceptAll(From(input2)); !validateQuery(q, want) {
+		t.Errorf("From(%v).ExceptAll(%v)=%v expected %v", input1, input2, toSlice(q), want)
+	}
+}
@@ -0,0 +1,102 @@
+package linq
+
+import "reflect"
+
+// Flatten flattens one level of nesting of a collection: elements that are
+// slices or arrays are replaced by their own elements, while any other element
+// is returned as is.
+//
+// Unlike SelectMany, Flatten needs no selector and handles collections that mix
+// nested and non-nested elements.
+func (q Query) Flatten() Query {
+	return Query{
+		Iterate: func() Iterator {
+			outernext := q.Iterate()
+			var innernext Iterator
+
+			return func() (item interface{}, ok bool) {
+				for {
+					if innernext != nil {
+						item, ok = innernext()
+						if ok {
+							return
+						}
+
+						innernext = nil
+					}
+
+					item, ok = outernext()
+					if !ok || !isSliceOrArray(item) {
+						return
+					}
+
+					innernext = From(item).Iterate()
+				}
+			}
+		},
+	}
+}
+
+// TotalCount returns the number of elements in a collection after one level of
+// nesting is flattened: elements that are slices or arrays count as their
+// length, while any other element counts as one. It is equivalent to
+// Flatten().Count(), but the nested elements are never enumerated.
+func (q Query) TotalCount() (r int) {
+	next := q.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		if isSliceOrArray(item) {
+			r += reflect.ValueOf(item).Len()
+		} else {
+			r++
+		}
+	}
+
+	return
+}
+
+// maxFlattenDepth is the number of levels of nesting FlattenDeep flattens at
+// most.
+const maxFlattenDepth = 1000
+
+// FlattenDeep flattens all levels of nesting of a collection: elements that are
+// slices or arrays are recursively replaced by their own elements, so that the
+// result contains no slices or arrays.
+//
+// To guard against slices that contain themselves, slices or arrays nested
+// more than 1000 levels deep are returned as is.
+func (q Query) FlattenDeep() Query {
+	return Query{
+		Iterate: func() Iterator {
+			stack := []Iterator{q.Iterate()}
+
+			return func() (item interface{}, ok bool) {
+				for len(stack) > 0 {
+					item, ok = stack[len(stack)-1]()
+					if !ok {
+						stack = stack[:len(stack)-1]
+						continue
+					}
+
+					if !isSliceOrArray(item) || len(stack) > maxFlattenDepth {
+						return
+					}
+
+					stack = append(stack, From(item).Iterate())
+				}
+
+				return nil, false
+			}
+		},
+	}
+}
+
+// isSliceOrArray reports whether item is a slice or an array.
+func isSliceOrArray(item interface{}) bool {
+	switch reflect.ValueOf(item).Kind() {
+	case reflect.Slice, reflect.Array:
+		return true
+	}
+
+	return false
+}
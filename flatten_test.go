@@ -0,0 +1,71 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		output []interface{}
+	}{
+		{[]interface{}{[]interface{}{1, 2}, 3, []int{4, 5}}, []interface{}{1, 2, 3, 4, 5}},
+		{[]interface{}{[]interface{}{1, []interface{}{2}}, [0]int{}}, []interface{}{1, []interface{}{2}}},
+		{[][]string{{"a"}, {}, {"b", "c"}}, []interface{}{"a", "b", "c"}},
+		{[]interface{}{"str", nil}, []interface{}{"str", nil}},
+		{[]interface{}{}, nil},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).Flatten().Results(); !reflect.DeepEqual(r, test.output) {
+			t.Errorf("From(%v).Flatten()=%v expected %v", test.input, r, test.output)
+		}
+	}
+}
+
+func TestTotalCount(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  int
+	}{
+		{[]interface{}{[]interface{}{1, 2}, 3, []int{4, 5}}, 5},
+		{[]interface{}{[]interface{}{1, []interface{}{2}}, [0]int{}}, 2},
+		{[][]string{{"a"}, {}, {"b", "c"}}, 3},
+		{[]interface{}{"str", nil}, 2},
+		{[]interface{}{}, 0},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).TotalCount(); r != test.want {
+			t.Errorf("From(%v).TotalCount()=%v expected %v", test.input, r, test.want)
+		}
+	}
+}
+
+func TestFlattenDeep(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		output []interface{}
+	}{
+		{[]interface{}{[]interface{}{1, []interface{}{2, []int{3}}}, 4, [0]int{}}, []interface{}{1, 2, 3, 4}},
+		{[][]string{{"a"}, {}, {"b", "c"}}, []interface{}{"a", "b", "c"}},
+		{[]interface{}{"str", nil}, []interface{}{"str", nil}},
+		{[]interface{}{[]interface{}{}}, nil},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).FlattenDeep().Results(); !reflect.DeepEqual(r, test.output) {
+			t.Errorf("From(%v).FlattenDeep()=%v expected %v", test.input, r, test.output)
+		}
+	}
+}
+
+func TestFlattenDeep_SelfReference(t *testing.T) {
+	cycle := []interface{}{1, nil}
+	cycle[1] = cycle
+
+	if r := From(cycle).FlattenDeep().Where(func(i interface{}) bool { return i == 1 }).Count(); r != maxFlattenDepth+1 {
+		t.Errorf("From(cycle).FlattenDeep() returned %d ones expected %d", r, maxFlattenDepth+1)
+	}
+}
@@ -129,6 +129,12 @@ func FromIterable(source Iterable) Query {
 	}
 }
 
+// Of initializes a linq query with the passed values as the source. Calling Of
+// without arguments results in an empty collection.
+func Of(values ...interface{}) Query {
+	return From(values)
+}
+
 // Range generates a sequence of integral numbers within a specified range.
 func Range(start, count int) Query {
 	return Query{
@@ -151,6 +157,53 @@ func Range(start, count int) Query {
 	}
 }
 
+// RangeStep generates a sequence of count integral numbers, starting at start
+// and incremented by step, which can be negative or zero. Like Range, it
+// doesn't guard against overflows: values wrap around as int arithmetic does.
+func RangeStep(start, step, count int) Query {
+	return Query{
+		Iterate: func() Iterator {
+			index := 0
+			current := start
+
+			return func() (item interface{}, ok bool) {
+				if index >= count {
+					return nil, false
+				}
+
+				item, ok = current, true
+
+				index++
+				current += step
+				return
+			}
+		},
+	}
+}
+
+// RangeFloat64 generates a sequence of count float64 values, starting at start
+// and incremented by step, which can be negative or zero. The i-th value is
+// computed as start + i*step rather than by repeated addition, so that
+// floating-point rounding errors don't accumulate along the sequence.
+func RangeFloat64(start, step float64, count int) Query {
+	return Query{
+		Iterate: func() Iterator {
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				if index >= count {
+					return nil, false
+				}
+
+				item, ok = start+float64(index)*step, true
+
+				index++
+				return
+			}
+		},
+	}
+}
+
 // Repeat generates a sequence that contains one repeated value.
 func Repeat(value interface{}, count int) Query {
 	return Query{
@@ -69,6 +69,18 @@ func TestFromIterable(t *testing.T) {
 	}
 }
 
+func TestOf(t *testing.T) {
+	w := []interface{}{1, "a", 2.5}
+
+	if q := Of(1, "a", 2.5); !validateQuery(q, w) {
+		t.Errorf("Of(1, \"a\", 2.5)=%v expected %v", toSlice(q), w)
+	}
+
+	if q := Of(); !validateQuery(q, []interface{}{}) {
+		t.Errorf("Of()=%v expected []", toSlice(q))
+	}
+}
+
 func TestRange(t *testing.T) {
 	w := []interface{}{-2, -1, 0, 1, 2}
 
@@ -77,6 +89,47 @@ func TestRange(t *testing.T) {
 	}
 }
 
+func TestRangeFloat64(t *testing.T) {
+	tests := []struct {
+		start, step float64
+		count       int
+		output      []interface{}
+	}{
+		{0, 0.25, 4, []interface{}{0.0, 0.25, 0.5, 0.75}},
+		{1, -0.5, 3, []interface{}{1.0, 0.5, 0.0}},
+		{1, 1, -1, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := RangeFloat64(test.start, test.step, test.count); !validateQuery(q, test.output) {
+			t.Errorf("RangeFloat64(%v, %v, %d)=%v expected %v", test.start, test.step, test.count, toSlice(q), test.output)
+		}
+	}
+
+	if r := RangeFloat64(0, 0.1, 31).Last(); r != 3.0 {
+		t.Errorf("RangeFloat64(0, 0.1, 31).Last()=%v expected 3", r)
+	}
+}
+
+func TestRangeStep(t *testing.T) {
+	tests := []struct {
+		start, step, count int
+		output             []interface{}
+	}{
+		{0, 5, 4, []interface{}{0, 5, 10, 15}},
+		{10, -3, 3, []interface{}{10, 7, 4}},
+		{2, 0, 3, []interface{}{2, 2, 2}},
+		{1, 1, 0, []interface{}{}},
+		{1, 1, -1, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := RangeStep(test.start, test.step, test.count); !validateQuery(q, test.output) {
+			t.Errorf("RangeStep(%d, %d, %d)=%v expected %v", test.start, test.step, test.count, toSlice(q), test.output)
+		}
+	}
+}
+
 func TestRepeat(t *testing.T) {
 	w := []interface{}{1, 1, 1, 1, 1}
 
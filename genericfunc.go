@@ -39,6 +39,20 @@ func (g *genericFunc) Call(params ...interface{}) interface{} {
 	return nil
 }
 
+// CallMulti calls a dynamic function and returns all of its results.
+func (g *genericFunc) CallMulti(params ...interface{}) []interface{} {
+	paramsIn := make([]reflect.Value, len(params))
+	for i, param := range params {
+		paramsIn[i] = reflect.ValueOf(param)
+	}
+	paramsOut := g.Cache.FnValue.Call(paramsIn)
+	results := make([]interface{}, len(paramsOut))
+	for i, paramOut := range paramsOut {
+		results[i] = paramOut.Interface()
+	}
+	return results
+}
+
 // newGenericFunc instantiates a new genericFunc pointer
 func newGenericFunc(methodName, paramName string, fn interface{}, validateFunc func(*functionCache) error) (*genericFunc, error) {
 	cache := &functionCache{}
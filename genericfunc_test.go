@@ -128,3 +128,19 @@ func TestCall(t *testing.T) {
 		}()
 	}
 }
+
+func TestCallMulti(t *testing.T) {
+	dynaFunc, err := newGenericFunc(
+		"TestCallMulti", "test1",
+		func(i int) (int, bool) { return i * 3, i > 0 },
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(int), new(bool))),
+	)
+	if err != nil {
+		t.Errorf("expect error: nil, actual: %s", err)
+	}
+
+	want := []interface{}{9, true}
+	if result := dynaFunc.CallMulti(3); !reflect.DeepEqual(result, want) {
+		t.Errorf("expect result: %v, actual: %v", want, result)
+	}
+}
@@ -9,26 +9,31 @@ type Group struct {
 // GroupBy method groups the elements of a collection according to a specified
 // key selector function and projects the elements for each group by using a
 // specified function.
+//
+// The groups are returned in the order in which their keys are first
+// encountered in the collection, and the elements of each group keep their
+// original order.
 func (q Query) GroupBy(keySelector func(interface{}) interface{},
 	elementSelector func(interface{}) interface{}) Query {
 	return Query{
 		func() Iterator {
 			next := q.Iterate()
-			set := make(map[interface{}][]interface{})
+			set := make(map[interface{}]int)
+			var groups []Group
 
 			for item, ok := next(); ok; item, ok = next() {
 				key := keySelector(item)
-				set[key] = append(set[key], elementSelector(item))
-			}
+				idx, has := set[key]
+				if !has {
+					idx = len(groups)
+					set[key] = idx
+					groups = append(groups, Group{Key: key})
+				}
 
-			len := len(set)
-			idx := 0
-			groups := make([]Group, len)
-			for k, v := range set {
-				groups[idx] = Group{k, v}
-				idx++
+				groups[idx].Group = append(groups[idx].Group, elementSelector(item))
 			}
 
+			len := len(groups)
 			index := 0
 
 			return func() (item interface{}, ok bool) {
@@ -79,3 +84,113 @@ func (q Query) GroupByT(keySelectorFn interface{},
 
 	return q.GroupBy(keySelectorFunc, elementSelectorFunc)
 }
+
+// ToLookup iterates over a collection and groups its elements into a map
+// according to a specified key selector function. The elements of each group
+// preserve their relative order in the source collection.
+//
+// Unlike GroupBy, ToLookup is not deferred: the whole collection is enumerated
+// once and the resulting map can be indexed repeatedly without enumerating the
+// collection again.
+func (q Query) ToLookup(keySelector func(interface{}) interface{}) map[interface{}][]interface{} {
+	next := q.Iterate()
+	lookup := make(map[interface{}][]interface{})
+
+	for item, ok := next(); ok; item, ok = next() {
+		key := keySelector(item)
+		lookup[key] = append(lookup[key], item)
+	}
+
+	return lookup
+}
+
+// ToLookupT is the typed version of ToLookup.
+//
+//   - keySelectorFn is of type "func(TSource) TKey"
+//
+// NOTE: ToLookup has better performance than ToLookupT.
+func (q Query) ToLookupT(keySelectorFn interface{}) map[interface{}][]interface{} {
+	keySelectorGenericFunc, err := newGenericFunc(
+		"ToLookupT", "keySelectorFn", keySelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	keySelectorFunc := func(item interface{}) interface{} {
+		return keySelectorGenericFunc.Call(item)
+	}
+
+	return q.ToLookup(keySelectorFunc)
+}
+
+// GroupAdjacent groups consecutive elements of a collection that share the same
+// key, according to a specified key selector function. Unlike GroupBy, only
+// adjacent elements are grouped together, so a key that occurs in several runs
+// produces several groups. Groups are returned in the order of the source
+// collection.
+func (q Query) GroupAdjacent(keySelector func(interface{}) interface{}) Query {
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			started := false
+			var pending, pendingKey interface{}
+			var hasPending bool
+
+			return func() (item interface{}, ok bool) {
+				if !started {
+					started = true
+					pending, hasPending = next()
+					if hasPending {
+						pendingKey = keySelector(pending)
+					}
+				}
+
+				if !hasPending {
+					return
+				}
+
+				group := Group{Key: pendingKey, Group: []interface{}{pending}}
+				for {
+					current, ok := next()
+					if !ok {
+						hasPending = false
+						break
+					}
+
+					key := keySelector(current)
+					if key != group.Key {
+						pending, pendingKey = current, key
+						break
+					}
+
+					group.Group = append(group.Group, current)
+				}
+
+				return group, true
+			}
+		},
+	}
+}
+
+// GroupAdjacentT is the typed version of GroupAdjacent.
+//
+//   - keySelectorFn is of type "func(TSource) TKey"
+//
+// NOTE: GroupAdjacent has better performance than GroupAdjacentT.
+func (q Query) GroupAdjacentT(keySelectorFn interface{}) Query {
+	keySelectorGenericFunc, err := newGenericFunc(
+		"GroupAdjacentT", "keySelectorFn", keySelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	keySelectorFunc := func(item interface{}) interface{} {
+		return keySelectorGenericFunc.Call(item)
+	}
+
+	return q.GroupAdjacent(keySelectorFunc)
+}
@@ -38,6 +38,26 @@ func TestGroupBy(t *testing.T) {
 	}
 }
 
+func TestGroupBy_KeyEncounterOrder(t *testing.T) {
+	input := []string{"cherry", "apple", "blueberry", "avocado", "banana", "coconut"}
+	want := []interface{}{
+		Group{"c", []interface{}{"cherry", "coconut"}},
+		Group{"a", []interface{}{"apple", "avocado"}},
+		Group{"b", []interface{}{"blueberry", "banana"}},
+	}
+
+	for i := 0; i < 10; i++ {
+		r := From(input).GroupBy(
+			func(i interface{}) interface{} { return i.(string)[:1] },
+			func(i interface{}) interface{} { return i },
+		).Results()
+
+		if !reflect.DeepEqual(r, want) {
+			t.Fatalf("From(%v).GroupBy()=%v expected %v", input, r, want)
+		}
+	}
+}
+
 func TestGroupByT_PanicWhenKeySelectorFnIsInvalid(t *testing.T) {
 	mustPanicWithError(t, "GroupByT: parameter [keySelectorFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int,int)bool'", func() {
 		var r []int
@@ -57,3 +77,57 @@ func TestGroupByT_PanicWhenElementSelectorFnIsInvalid(t *testing.T) {
 		).ToSlice(&r)
 	})
 }
+
+func TestToLookup(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	want := map[interface{}][]interface{}{
+		0: {2, 4, 6, 8},
+		1: {1, 3, 5, 7, 9},
+	}
+
+	lookup := From(input).ToLookup(func(i interface{}) interface{} {
+		return i.(int) % 2
+	})
+
+	if !reflect.DeepEqual(lookup, want) {
+		t.Errorf("From(%v).ToLookup()=%v expected %v", input, lookup, want)
+	}
+}
+
+func TestToLookupT_PanicWhenKeySelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "ToLookupT: parameter [keySelectorFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int,int)bool'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).ToLookupT(func(i, j int) bool { return true })
+	})
+}
+
+func TestGroupAdjacent(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		output []interface{}
+	}{
+		{[]int{1, 3, 2, 4, 6, 5, 8}, []interface{}{
+			Group{1, []interface{}{1, 3}},
+			Group{0, []interface{}{2, 4, 6}},
+			Group{1, []interface{}{5}},
+			Group{0, []interface{}{8}},
+		}},
+		{[]int{2}, []interface{}{Group{0, []interface{}{2}}}},
+		{[]int{}, nil},
+	}
+
+	for _, test := range tests {
+		r := From(test.input).GroupAdjacent(func(i interface{}) interface{} {
+			return i.(int) % 2
+		}).Results()
+
+		if !reflect.DeepEqual(r, test.output) {
+			t.Errorf("From(%v).GroupAdjacent()=%v expected %v", test.input, r, test.output)
+		}
+	}
+}
+
+func TestGroupAdjacentT_PanicWhenKeySelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "GroupAdjacentT: parameter [keySelectorFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int,int)bool'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).GroupAdjacentT(func(i, j int) bool { return true })
+	})
+}
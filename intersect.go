@@ -85,3 +85,27 @@ func (q Query) IntersectByT(q2 Query,
 
 	return q.IntersectBy(q2, selectorFunc)
 }
+
+// IntersectAll produces the multiset intersection of the source collection and
+// the provided input collection. Unlike Intersect, duplicates are kept: an
+// element appears in the result as many times as it appears in the collection
+// where it occurs the least. The order of the source collection is preserved.
+func (q Query) IntersectAll(q2 Query) Query {
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			counts := q2.Frequencies()
+
+			return func() (item interface{}, ok bool) {
+				for item, ok = next(); ok; item, ok = next() {
+					if counts[item] > 0 {
+						counts[item]--
+						return
+					}
+				}
+
+				return
+			}
+		},
+	}
+}
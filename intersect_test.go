@@ -31,3 +31,13 @@ func TestIntersectByT_PanicWhenSelectorFnIsInvalid(t *testing.T) {
 		})
 	})
 }
+
+func TestIntersectAll(t *testing.T) {
+	input1 := []int{1, 1, 2, 3, 1, 2}
+	input2 := []int{2, 1, 1, 4, 2, 2}
+	want := []interface{}{1, 1, 2, 2}
+
+	if q := From(input1).IntersectAll(From(input2)); !validateQuery(q, want) {
+		t.Errorf("From(%v).IntersectAll(%v)=%v expected %v", input1, input2, toSlice(q), want)
+	}
+}
@@ -103,3 +103,183 @@ func (q Query) JoinT(inner Query,
 
 	return q.Join(inner, outerKeySelectorFunc, innerKeySelectorFunc, resultSelectorFunc)
 }
+
+// LeftJoin correlates the elements of two collection based on matching keys,
+// like Join, but also returns the elements of the outer collection that have
+// no matching element in inner. For these elements, resultSelector is invoked
+// with a nil inner element.
+//
+// LeftJoin preserves the order of the elements of outer collection, and for
+// each of these elements, the order of the matching elements of inner.
+func (q Query) LeftJoin(inner Query,
+	outerKeySelector func(interface{}) interface{},
+	innerKeySelector func(interface{}) interface{},
+	resultSelector func(outer interface{}, inner interface{}) interface{}) Query {
+
+	return Query{
+		Iterate: func() Iterator {
+			outernext := q.Iterate()
+			innernext := inner.Iterate()
+
+			innerLookup := make(map[interface{}][]interface{})
+			for innerItem, ok := innernext(); ok; innerItem, ok = innernext() {
+				innerKey := innerKeySelector(innerItem)
+				innerLookup[innerKey] = append(innerLookup[innerKey], innerItem)
+			}
+
+			var outerItem interface{}
+			var innerGroup []interface{}
+			innerLen, innerIndex := 0, 0
+
+			return func() (item interface{}, ok bool) {
+				if innerIndex >= innerLen {
+					outerItem, ok = outernext()
+					if !ok {
+						return
+					}
+
+					innerGroup = innerLookup[outerKeySelector(outerItem)]
+					innerLen = len(innerGroup)
+					innerIndex = 0
+
+					if innerLen == 0 {
+						return resultSelector(outerItem, nil), true
+					}
+				}
+
+				item = resultSelector(outerItem, innerGroup[innerIndex])
+				innerIndex++
+				return item, true
+			}
+		},
+	}
+}
+
+// FullOuterJoin correlates the elements of two collection based on matching
+// keys, like Join, but also returns the elements of either collection that have
+// no matching element in the other one. For these elements, resultSelector is
+// invoked with a nil outer or inner element.
+//
+// FullOuterJoin first returns the results for the elements of outer collection,
+// in the same order as LeftJoin, followed by the unmatched elements of inner
+// collection in their original order.
+func (q Query) FullOuterJoin(inner Query,
+	outerKeySelector func(interface{}) interface{},
+	innerKeySelector func(interface{}) interface{},
+	resultSelector func(outer interface{}, inner interface{}) interface{}) Query {
+
+	return Query{
+		Iterate: func() Iterator {
+			outernext := q.Iterate()
+			innernext := inner.Iterate()
+
+			var innerItems, innerKeys []interface{}
+			innerLookup := make(map[interface{}][]interface{})
+			for innerItem, ok := innernext(); ok; innerItem, ok = innernext() {
+				innerKey := innerKeySelector(innerItem)
+				innerLookup[innerKey] = append(innerLookup[innerKey], innerItem)
+				innerItems = append(innerItems, innerItem)
+				innerKeys = append(innerKeys, innerKey)
+			}
+
+			matched := make(map[interface{}]bool)
+			outerDone := false
+			var outerItem interface{}
+			var innerGroup []interface{}
+			innerLen, innerIndex, leftoverIndex := 0, 0, 0
+
+			return func() (item interface{}, ok bool) {
+				if !outerDone && innerIndex >= innerLen {
+					outerItem, ok = outernext()
+					if ok {
+						outerKey := outerKeySelector(outerItem)
+						innerGroup = innerLookup[outerKey]
+						innerLen = len(innerGroup)
+						innerIndex = 0
+
+						if innerLen == 0 {
+							return resultSelector(outerItem, nil), true
+						}
+
+						matched[outerKey] = true
+					} else {
+						outerDone = true
+					}
+				}
+
+				if !outerDone {
+					item = resultSelector(outerItem, innerGroup[innerIndex])
+					innerIndex++
+					return item, true
+				}
+
+				for leftoverIndex < len(innerItems) {
+					leftoverIndex++
+					if !matched[innerKeys[leftoverIndex-1]] {
+						return resultSelector(nil, innerItems[leftoverIndex-1]), true
+					}
+				}
+
+				return nil, false
+			}
+		},
+	}
+}
+
+// CrossJoin produces the cartesian product of two collections: resultSelector
+// is invoked for every pair made of an element of outer collection and an
+// element of inner collection. The results are ordered by the elements of outer
+// collection, then by the elements of inner collection.
+func (q Query) CrossJoin(inner Query,
+	resultSelector func(outer interface{}, inner interface{}) interface{}) Query {
+
+	return Query{
+		Iterate: func() Iterator {
+			outernext := q.Iterate()
+			innerItems := inner.Results()
+
+			var outerItem interface{}
+			innerLen, innerIndex := len(innerItems), len(innerItems)
+
+			return func() (item interface{}, ok bool) {
+				if innerLen == 0 {
+					return
+				}
+
+				if innerIndex >= innerLen {
+					outerItem, ok = outernext()
+					if !ok {
+						return
+					}
+
+					innerIndex = 0
+				}
+
+				item = resultSelector(outerItem, innerItems[innerIndex])
+				innerIndex++
+				return item, true
+			}
+		},
+	}
+}
+
+// CrossJoinT is the typed version of CrossJoin.
+//
+//   - resultSelectorFn is of type "func(TOuter,TInner) TResult"
+//
+// NOTE: CrossJoin has better performance than CrossJoinT.
+func (q Query) CrossJoinT(inner Query, resultSelectorFn interface{}) Query {
+	resultSelectorGenericFunc, err := newGenericFunc(
+		"CrossJoinT", "resultSelectorFn", resultSelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType), new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	resultSelectorFunc := func(outer interface{}, inner interface{}) interface{} {
+		return resultSelectorGenericFunc.Call(outer, inner)
+	}
+
+	return q.CrossJoin(inner, resultSelectorFunc)
+}
@@ -58,3 +58,95 @@ func TestJoinT_PanicWhenResultSelectorFnIsInvalid(t *testing.T) {
 		)
 	})
 }
+
+func TestLeftJoin(t *testing.T) {
+	outer := []int{0, 1, 2, 3, 4, 5, 8}
+	inner := []int{1, 2, 1, 4, 7, 6, 7, 2}
+	want := []interface{}{
+		KeyValue{0, nil},
+		KeyValue{1, 1},
+		KeyValue{1, 1},
+		KeyValue{2, 2},
+		KeyValue{2, 2},
+		KeyValue{3, nil},
+		KeyValue{4, 4},
+		KeyValue{5, nil},
+		KeyValue{8, nil},
+	}
+
+	q := From(outer).LeftJoin(
+		From(inner),
+		func(i interface{}) interface{} { return i },
+		func(i interface{}) interface{} { return i },
+		func(outer interface{}, inner interface{}) interface{} {
+			return KeyValue{outer, inner}
+		})
+
+	if !validateQuery(q, want) {
+		t.Errorf("From().LeftJoin()=%v expected %v", toSlice(q), want)
+	}
+}
+
+func TestFullOuterJoin(t *testing.T) {
+	outer := []int{0, 1, 2, 5}
+	inner := []int{7, 1, 2, 1, 6, 2}
+	want := []interface{}{
+		KeyValue{0, nil},
+		KeyValue{1, 1},
+		KeyValue{1, 1},
+		KeyValue{2, 2},
+		KeyValue{2, 2},
+		KeyValue{5, nil},
+		KeyValue{nil, 7},
+		KeyValue{nil, 6},
+	}
+
+	q := From(outer).FullOuterJoin(
+		From(inner),
+		func(i interface{}) interface{} { return i },
+		func(i interface{}) interface{} { return i },
+		func(outer interface{}, inner interface{}) interface{} {
+			return KeyValue{outer, inner}
+		})
+
+	if !validateQuery(q, want) {
+		t.Errorf("From().FullOuterJoin()=%v expected %v", toSlice(q), want)
+	}
+
+	if q := From([]int{}).FullOuterJoin(From([]int{}), nil, nil, nil); q.Any() {
+		t.Errorf("From([]).FullOuterJoin([])=%v expected []", toSlice(q))
+	}
+}
+
+func TestCrossJoin(t *testing.T) {
+	tests := []struct {
+		outer  interface{}
+		inner  interface{}
+		output []interface{}
+	}{
+		{[]int{1, 2}, "ab", []interface{}{
+			KeyValue{1, 'a'}, KeyValue{1, 'b'}, KeyValue{2, 'a'}, KeyValue{2, 'b'},
+		}},
+		{[]int{1, 2}, "", []interface{}{}},
+		{[]int{}, "ab", []interface{}{}},
+	}
+
+	for _, test := range tests {
+		q := From(test.outer).CrossJoin(From(test.inner), func(outer interface{}, inner interface{}) interface{} {
+			return KeyValue{outer, inner}
+		})
+
+		if !validateQuery(q, test.output) {
+			t.Errorf("From(%v).CrossJoin(%v)=%v expected %v", test.outer, test.inner, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestCrossJoinT_PanicWhenResultSelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "CrossJoinT: parameter [resultSelectorFn] has a invalid function signature. Expected: 'func(T,T)T', actual: 'func(int,int,int)linq.KeyValue'", func() {
+		From([]int{0, 1, 2}).CrossJoinT(
+			From([]int{1, 2, 3}),
+			func(outer int, inner, j int) KeyValue { return KeyValue{outer, inner} },
+		)
+	})
+}
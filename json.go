@@ -0,0 +1,37 @@
+package linq
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// FromJSON initializes a linq query with the elements of a JSON array. The
+// elements are decoded the same way encoding/json decodes into an interface{}
+// value: objects become map[string]interface{}, arrays become []interface{}
+// and numbers become float64.
+//
+// An error is returned if data is not a valid JSON array.
+func FromJSON(data []byte) (Query, error) {
+	var source []interface{}
+	if err := json.Unmarshal(data, &source); err != nil {
+		return Query{}, fmt.Errorf("FromJSON: %w", err)
+	}
+
+	return From(source), nil
+}
+
+// ToJSON iterates over a collection and encodes its elements as a JSON array.
+// An empty collection is encoded as an empty array.
+func (q Query) ToJSON() ([]byte, error) {
+	r := q.Results()
+	if r == nil {
+		r = []interface{}{}
+	}
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil, fmt.Errorf("ToJSON: %w", err)
+	}
+
+	return data, nil
+}
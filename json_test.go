@@ -0,0 +1,57 @@
+package linq
+
+import "testing"
+
+func TestFromJSON(t *testing.T) {
+	tests := []struct {
+		input  string
+		output []interface{}
+	}{
+		{`[1, 2.5, "a", true, null]`, []interface{}{1.0, 2.5, "a", true, nil}},
+		{`[]`, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		q, err := FromJSON([]byte(test.input))
+		if err != nil {
+			t.Errorf("FromJSON(%v) returned error %v", test.input, err)
+			continue
+		}
+
+		if !validateQuery(q, test.output) {
+			t.Errorf("FromJSON(%v)=%v expected %v", test.input, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestFromJSON_Malformed(t *testing.T) {
+	for _, input := range []string{`[1, 2`, `{"a": 1}`, ``} {
+		if _, err := FromJSON([]byte(input)); err == nil {
+			t.Errorf("FromJSON(%v) expected an error", input)
+		}
+	}
+}
+
+func TestToJSON(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		output string
+	}{
+		{[]int{1, 2, 3}, `[1,2,3]`},
+		{[]string{"a", "b"}, `["a","b"]`},
+		{[]int{}, `[]`},
+	}
+
+	for _, test := range tests {
+		r, err := From(test.input).ToJSON()
+		if err != nil || string(r) != test.output {
+			t.Errorf("From(%v).ToJSON()=%s,%v expected %v", test.input, r, err, test.output)
+		}
+	}
+}
+
+func TestToJSON_Unsupported(t *testing.T) {
+	if _, err := From([]interface{}{make(chan int)}).ToJSON(); err == nil {
+		t.Errorf("ToJSON() expected an error for an unsupported type")
+	}
+}
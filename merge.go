@@ -0,0 +1,55 @@
+package linq
+
+// SortedMerge merges two collections that are both sorted in ascending order
+// according to the specified less function into a single sorted collection.
+// Unlike concatenating the collections and sorting the result, it makes a
+// single pass over each collection. When elements of both collections are
+// equal, the elements of the first collection come first.
+//
+// Both collections must already be sorted according to less, otherwise the
+// result is not sorted.
+func (q Query) SortedMerge(q2 Query, less func(a, b interface{}) bool) Query {
+	return Query{
+		Iterate: func() Iterator {
+			next1 := q.Iterate()
+			next2 := q2.Iterate()
+
+			item1, ok1 := next1()
+			item2, ok2 := next2()
+
+			return func() (item interface{}, ok bool) {
+				switch {
+				case ok1 && (!ok2 || !less(item2, item1)):
+					item, ok = item1, true
+					item1, ok1 = next1()
+				case ok2:
+					item, ok = item2, true
+					item2, ok2 = next2()
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// SortedMergeT is the typed version of SortedMerge.
+//
+//   - lessFn is of type "func(TSource,TSource) bool"
+//
+// NOTE: SortedMerge has better performance than SortedMergeT.
+func (q Query) SortedMergeT(q2 Query, lessFn interface{}) Query {
+	lessGenericFunc, err := newGenericFunc(
+		"SortedMergeT", "lessFn", lessFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType), new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	lessFunc := func(a, b interface{}) bool {
+		return lessGenericFunc.Call(a, b).(bool)
+	}
+
+	return q.SortedMerge(q2, lessFunc)
+}
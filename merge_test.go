@@ -0,0 +1,36 @@
+package linq
+
+import "testing"
+
+func TestSortedMerge(t *testing.T) {
+	less := func(a, b interface{}) bool {
+		return a.(foo).f1 < b.(foo).f1
+	}
+
+	tests := []struct {
+		input1 []foo
+		input2 []foo
+		output []interface{}
+	}{
+		{
+			[]foo{{f1: 1}, {f1: 3, f3: "a"}, {f1: 5}},
+			[]foo{{f1: 2}, {f1: 3, f3: "b"}, {f1: 6}, {f1: 7}},
+			[]interface{}{foo{f1: 1}, foo{f1: 2}, foo{f1: 3, f3: "a"}, foo{f1: 3, f3: "b"}, foo{f1: 5}, foo{f1: 6}, foo{f1: 7}},
+		},
+		{[]foo{}, []foo{{f1: 1}}, []interface{}{foo{f1: 1}}},
+		{[]foo{{f1: 1}}, []foo{}, []interface{}{foo{f1: 1}}},
+		{[]foo{}, []foo{}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input1).SortedMerge(From(test.input2), less); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).SortedMerge(%v)=%v expected %v", test.input1, test.input2, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestSortedMergeT_PanicWhenLessFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "SortedMergeT: parameter [lessFn] has a invalid function signature. Expected: 'func(T,T)bool', actual: 'func(int)bool'", func() {
+		From([]int{1, 2}).SortedMergeT(From([]int{3}), func(item int) bool { return item > 1 })
+	})
+}
@@ -1,9 +1,13 @@
 package linq
 
-import "sort"
+import (
+	"sort"
+	"time"
+)
 
 type order struct {
 	selector func(interface{}) interface{}
+	less     func(interface{}, interface{}) bool
 	compare  comparer
 	desc     bool
 }
@@ -108,6 +112,203 @@ func (q Query) OrderByDescendingT(selectorFn interface{}) OrderedQuery {
 	return q.OrderByDescending(selectorFunc)
 }
 
+// OrderByKey sorts the elements of a collection in ascending order. Elements are
+// sorted according to a key, and keys are compared with the specified less
+// function, which should return true if key a is less than key b.
+//
+// The key selector is invoked only once for each element and the keys are
+// cached for the duration of the sort, so OrderByKey is a good fit for keys
+// that are expensive to compute or that are not of a type known to linq.
+func (q Query) OrderByKey(keySelector func(interface{}) interface{},
+	keyLess func(a, b interface{}) bool) OrderedQuery {
+	return OrderedQuery{
+		orders:   []order{{selector: keySelector, less: keyLess}},
+		original: q,
+		Query: Query{
+			Iterate: func() Iterator {
+				items := q.sort([]order{{selector: keySelector, less: keyLess}})
+				len := len(items)
+				index := 0
+
+				return func() (item interface{}, ok bool) {
+					ok = index < len
+					if ok {
+						item = items[index]
+						index++
+					}
+
+					return
+				}
+			},
+		},
+	}
+}
+
+// OrderByKeyT is the typed version of OrderByKey.
+//
+//   - keySelectorFn is of type "func(TSource) TKey"
+//   - keyLessFn is of type "func(TKey, TKey) bool"
+//
+// NOTE: OrderByKey has better performance than OrderByKeyT.
+func (q Query) OrderByKeyT(keySelectorFn interface{}, keyLessFn interface{}) OrderedQuery {
+	keySelectorGenericFunc, err := newGenericFunc(
+		"OrderByKeyT", "keySelectorFn", keySelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	keySelectorFunc := func(item interface{}) interface{} {
+		return keySelectorGenericFunc.Call(item)
+	}
+
+	keyLessGenericFunc, err := newGenericFunc(
+		"OrderByKeyT", "keyLessFn", keyLessFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType), new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	keyLessFunc := func(a, b interface{}) bool {
+		return keyLessGenericFunc.Call(a, b).(bool)
+	}
+
+	return q.OrderByKey(keySelectorFunc, keyLessFunc)
+}
+
+// OrderByIntKey sorts the elements of a collection in ascending order according
+// to an int key. The sort is stable, so elements with equal keys keep their
+// original order, and the result can be ordered further with ThenBy or
+// ThenByDescending. keySelector is invoked only once for each element.
+func (q Query) OrderByIntKey(keySelector func(interface{}) int) OrderedQuery {
+	return q.OrderByKey(
+		func(item interface{}) interface{} { return keySelector(item) },
+		func(a, b interface{}) bool { return a.(int) < b.(int) },
+	)
+}
+
+// OrderByIntKeyT is the typed version of OrderByIntKey.
+//
+//   - keySelectorFn is of type "func(TSource) int"
+//
+// NOTE: OrderByIntKey has better performance than OrderByIntKeyT.
+func (q Query) OrderByIntKeyT(keySelectorFn interface{}) OrderedQuery {
+	keySelectorGenericFunc, err := newGenericFunc(
+		"OrderByIntKeyT", "keySelectorFn", keySelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(int))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	keySelectorFunc := func(item interface{}) int {
+		return keySelectorGenericFunc.Call(item).(int)
+	}
+
+	return q.OrderByIntKey(keySelectorFunc)
+}
+
+// OrderByStringKey sorts the elements of a collection in ascending order according
+// to a string key. The sort is stable, so elements with equal keys keep their
+// original order, and the result can be ordered further with ThenBy or
+// ThenByDescending. keySelector is invoked only once for each element.
+func (q Query) OrderByStringKey(keySelector func(interface{}) string) OrderedQuery {
+	return q.OrderByKey(
+		func(item interface{}) interface{} { return keySelector(item) },
+		func(a, b interface{}) bool { return a.(string) < b.(string) },
+	)
+}
+
+// OrderByStringKeyT is the typed version of OrderByStringKey.
+//
+//   - keySelectorFn is of type "func(TSource) string"
+//
+// NOTE: OrderByStringKey has better performance than OrderByStringKeyT.
+func (q Query) OrderByStringKeyT(keySelectorFn interface{}) OrderedQuery {
+	keySelectorGenericFunc, err := newGenericFunc(
+		"OrderByStringKeyT", "keySelectorFn", keySelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(string))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	keySelectorFunc := func(item interface{}) string {
+		return keySelectorGenericFunc.Call(item).(string)
+	}
+
+	return q.OrderByStringKey(keySelectorFunc)
+}
+
+// OrderByFloat64Key sorts the elements of a collection in ascending order according
+// to a float64 key. The sort is stable, so elements with equal keys keep their
+// original order, and the result can be ordered further with ThenBy or
+// ThenByDescending. keySelector is invoked only once for each element.
+func (q Query) OrderByFloat64Key(keySelector func(interface{}) float64) OrderedQuery {
+	return q.OrderByKey(
+		func(item interface{}) interface{} { return keySelector(item) },
+		func(a, b interface{}) bool { return a.(float64) < b.(float64) },
+	)
+}
+
+// OrderByFloat64KeyT is the typed version of OrderByFloat64Key.
+//
+//   - keySelectorFn is of type "func(TSource) float64"
+//
+// NOTE: OrderByFloat64Key has better performance than OrderByFloat64KeyT.
+func (q Query) OrderByFloat64KeyT(keySelectorFn interface{}) OrderedQuery {
+	keySelectorGenericFunc, err := newGenericFunc(
+		"OrderByFloat64KeyT", "keySelectorFn", keySelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(float64))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	keySelectorFunc := func(item interface{}) float64 {
+		return keySelectorGenericFunc.Call(item).(float64)
+	}
+
+	return q.OrderByFloat64Key(keySelectorFunc)
+}
+
+// OrderByTimeKey sorts the elements of a collection in chronological order
+// according to a time.Time key. The sort is stable, so elements with equal keys
+// keep their original order, and the result can be ordered further with ThenBy
+// or ThenByDescending. keySelector is invoked only once for each element.
+//
+// A collection of time.Time values can be sorted by passing a keySelector that
+// asserts the element to time.Time.
+func (q Query) OrderByTimeKey(keySelector func(interface{}) time.Time) OrderedQuery {
+	return q.OrderByKey(
+		func(item interface{}) interface{} { return keySelector(item) },
+		func(a, b interface{}) bool { return a.(time.Time).Before(b.(time.Time)) },
+	)
+}
+
+// OrderByTimeKeyT is the typed version of OrderByTimeKey.
+//
+//   - keySelectorFn is of type "func(TSource) time.Time"
+//
+// NOTE: OrderByTimeKey has better performance than OrderByTimeKeyT.
+func (q Query) OrderByTimeKeyT(keySelectorFn interface{}) OrderedQuery {
+	keySelectorGenericFunc, err := newGenericFunc(
+		"OrderByTimeKeyT", "keySelectorFn", keySelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(time.Time))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	keySelectorFunc := func(item interface{}) time.Time {
+		return keySelectorGenericFunc.Call(item).(time.Time)
+	}
+
+	return q.OrderByTimeKey(keySelectorFunc)
+}
+
 // ThenBy performs a subsequent ordering of the elements in a collection in
 // ascending order. This method enables you to specify multiple sort criteria by
 // applying any number of ThenBy or ThenByDescending methods.
@@ -155,6 +356,75 @@ func (oq OrderedQuery) ThenByT(selectorFn interface{}) OrderedQuery {
 	return oq.ThenBy(selectorFunc)
 }
 
+// ThenByKey performs a subsequent ordering of the elements in a collection in
+// ascending order. Elements are sorted according to a key, and keys are
+// compared with the specified less function, which should return true if key a
+// is less than key b.
+//
+// As with OrderByKey, the key selector is invoked only once for each element.
+// The sort is stable, so elements that are equal according to every key keep
+// their original order.
+func (oq OrderedQuery) ThenByKey(keySelector func(interface{}) interface{},
+	keyLess func(a, b interface{}) bool) OrderedQuery {
+	orders := append(oq.orders[:len(oq.orders):len(oq.orders)], order{selector: keySelector, less: keyLess})
+
+	return OrderedQuery{
+		orders:   orders,
+		original: oq.original,
+		Query: Query{
+			Iterate: func() Iterator {
+				items := oq.original.sort(orders)
+				len := len(items)
+				index := 0
+
+				return func() (item interface{}, ok bool) {
+					ok = index < len
+					if ok {
+						item = items[index]
+						index++
+					}
+
+					return
+				}
+			},
+		},
+	}
+}
+
+// ThenByKeyT is the typed version of ThenByKey.
+//
+//   - keySelectorFn is of type "func(TSource) TKey"
+//   - keyLessFn is of type "func(TKey, TKey) bool"
+//
+// NOTE: ThenByKey has better performance than ThenByKeyT.
+func (oq OrderedQuery) ThenByKeyT(keySelectorFn interface{}, keyLessFn interface{}) OrderedQuery {
+	keySelectorGenericFunc, err := newGenericFunc(
+		"ThenByKeyT", "keySelectorFn", keySelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	keySelectorFunc := func(item interface{}) interface{} {
+		return keySelectorGenericFunc.Call(item)
+	}
+
+	keyLessGenericFunc, err := newGenericFunc(
+		"ThenByKeyT", "keyLessFn", keyLessFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType), new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	keyLessFunc := func(a, b interface{}) bool {
+		return keyLessGenericFunc.Call(a, b).(bool)
+	}
+
+	return oq.ThenByKey(keySelectorFunc, keyLessFunc)
+}
+
 // ThenByDescending performs a subsequent ordering of the elements in a
 // collection in descending order. This method enables you to specify multiple
 // sort criteria by applying any number of ThenBy or ThenByDescending methods.
@@ -247,6 +517,51 @@ func (q Query) SortT(lessFn interface{}) Query {
 	return q.Sort(lessFunc)
 }
 
+// SortStable returns a new query by sorting elements with provided less
+// function in ascending order. Unlike Sort, the sort is stable: elements that
+// are equal according to less keep their original order, which makes the
+// result reproducible when sorting in multiple passes.
+func (q Query) SortStable(less func(i, j interface{}) bool) Query {
+	return Query{
+		Iterate: func() Iterator {
+			items := q.lessSortStable(less)
+			len := len(items)
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				ok = index < len
+				if ok {
+					item = items[index]
+					index++
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// SortStableT is the typed version of SortStable.
+//
+//   - lessFn is of type "func(TSource,TSource) bool"
+//
+// NOTE: SortStable has better performance than SortStableT.
+func (q Query) SortStableT(lessFn interface{}) Query {
+	lessGenericFunc, err := newGenericFunc(
+		"SortStableT", "lessFn", lessFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType), new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	lessFunc := func(i, j interface{}) bool {
+		return lessGenericFunc.Call(i, j).(bool)
+	}
+
+	return q.SortStable(lessFunc)
+}
+
 type sorter struct {
 	items []interface{}
 	less  func(i, j interface{}) bool
@@ -264,6 +579,40 @@ func (s sorter) Less(i, j int) bool {
 	return s.less(s.items[i], s.items[j])
 }
 
+// keyedSorter sorts items by their precomputed keys.
+type keyedSorter struct {
+	items []interface{}
+	keys  [][]interface{}
+	less  func(i, j []interface{}) bool
+}
+
+func (s keyedSorter) Len() int {
+	return len(s.items)
+}
+
+func (s keyedSorter) Swap(i, j int) {
+	s.items[i], s.items[j] = s.items[j], s.items[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
+
+func (s keyedSorter) Less(i, j int) bool {
+	return s.less(s.keys[i], s.keys[j])
+}
+
+// getLessComparer creates a comparer from a less function.
+func getLessComparer(less func(interface{}, interface{}) bool) comparer {
+	return func(x, y interface{}) int {
+		switch {
+		case less(x, y):
+			return -1
+		case less(y, x):
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
 func (q Query) sort(orders []order) (r []interface{}) {
 	next := q.Iterate()
 	for item, ok := next(); ok; item, ok = next() {
@@ -274,16 +623,30 @@ func (q Query) sort(orders []order) (r []interface{}) {
 		return
 	}
 
+	// every key is selected exactly once and cached, so that selectors are not
+	// invoked over and over again by the comparisons during the sort.
+	keys := make([][]interface{}, len(r))
+	for i, item := range r {
+		keys[i] = make([]interface{}, len(orders))
+		for j, order := range orders {
+			keys[i][j] = order.selector(item)
+		}
+	}
+
 	for i, j := range orders {
-		orders[i].compare = getComparer(j.selector(r[0]))
+		if j.less != nil {
+			orders[i].compare = getLessComparer(j.less)
+		} else {
+			orders[i].compare = getComparer(keys[0][i])
+		}
 	}
 
-	s := sorter{
+	s := keyedSorter{
 		items: r,
-		less: func(i, j interface{}) bool {
-			for _, order := range orders {
-				x, y := order.selector(i), order.selector(j)
-				switch order.compare(x, y) {
+		keys:  keys,
+		less: func(i, j []interface{}) bool {
+			for k, order := range orders {
+				switch order.compare(i[k], j[k]) {
 				case 0:
 					continue
 				case -1:
@@ -296,7 +659,7 @@ func (q Query) sort(orders []order) (r []interface{}) {
 			return false
 		}}
 
-	sort.Sort(s)
+	sort.Stable(s)
 	return
 }
 
@@ -311,3 +674,15 @@ func (q Query) lessSort(less func(i, j interface{}) bool) (r []interface{}) {
 	sort.Sort(s)
 	return
 }
+
+func (q Query) lessSortStable(less func(i, j interface{}) bool) (r []interface{}) {
+	next := q.Iterate()
+	for item, ok := next(); ok; item, ok = next() {
+		r = append(r, item)
+	}
+
+	s := sorter{items: r, less: less}
+
+	sort.Stable(s)
+	return
+}
@@ -1,6 +1,10 @@
 package linq
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+	"time"
+)
 
 func TestEmpty(t *testing.T) {
 	q := From([]string{}).OrderBy(func(in interface{}) interface{} {
@@ -69,6 +73,173 @@ func TestOrderByDescendingT_PanicWhenSelectorFnIsInvalid(t *testing.T) {
 	})
 }
 
+func TestOrderByKey(t *testing.T) {
+	input := []string{"ccc", "a", "dddd", "bb"}
+	want := []interface{}{"a", "bb", "ccc", "dddd"}
+
+	calls := 0
+	q := From(input).OrderByKey(func(i interface{}) interface{} {
+		calls++
+		return []rune(i.(string))
+	}, func(a, b interface{}) bool {
+		return len(a.([]rune)) < len(b.([]rune))
+	})
+
+	if r := q.Results(); !reflect.DeepEqual(r, want) {
+		t.Errorf("From(%v).OrderByKey()=%v expected %v", input, r, want)
+	}
+
+	if calls != len(input) {
+		t.Errorf("OrderByKey() invoked keySelector %d times, expected %d", calls, len(input))
+	}
+}
+
+func TestOrderByKeyT_PanicWhenKeySelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "OrderByKeyT: parameter [keySelectorFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int,int)int'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).OrderByKeyT(
+			func(item, j int) int { return item + 2 },
+			func(a, b int) bool { return a < b },
+		)
+	})
+}
+
+func TestOrderByKeyT_PanicWhenKeyLessFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "OrderByKeyT: parameter [keyLessFn] has a invalid function signature. Expected: 'func(T,T)bool', actual: 'func(int)bool'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).OrderByKeyT(
+			func(item int) int { return item + 2 },
+			func(a int) bool { return a < 0 },
+		)
+	})
+}
+
+func TestThenByKey(t *testing.T) {
+	input := []foo{{f1: 2, f3: "bb"}, {f1: 1, f3: "a"}, {f1: 2, f3: "c"}, {f1: 1, f3: "bb"}, {f1: 2, f3: "d"}}
+	want := []interface{}{input[1], input[3], input[2], input[4], input[0]}
+
+	calls := 0
+	q := From(input).OrderBy(func(i interface{}) interface{} {
+		return i.(foo).f1
+	}).ThenByKey(func(i interface{}) interface{} {
+		calls++
+		return len(i.(foo).f3)
+	}, func(a, b interface{}) bool {
+		return a.(int) < b.(int)
+	})
+
+	if r := q.Results(); !reflect.DeepEqual(r, want) {
+		t.Errorf("From(%v).OrderBy().ThenByKey()=%v expected %v", input, r, want)
+	}
+
+	if calls != len(input) {
+		t.Errorf("ThenByKey() invoked keySelector %d times, expected %d", calls, len(input))
+	}
+}
+
+func TestThenByKeyT_PanicWhenKeyLessFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "ThenByKeyT: parameter [keyLessFn] has a invalid function signature. Expected: 'func(T,T)bool', actual: 'func(int)bool'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).OrderBy(func(i interface{}) interface{} { return i }).ThenByKeyT(
+			func(item int) int { return item + 2 },
+			func(a int) bool { return a < 0 },
+		)
+	})
+}
+
+func TestOrderByIntKey(t *testing.T) {
+	input := []foo{{f1: 3, f3: "a"}, {f1: 1, f3: "b"}, {f1: 3, f3: "c"}, {f1: 2, f3: "d"}}
+	want := []interface{}{input[1], input[3], input[0], input[2]}
+
+	q := From(input).OrderByIntKey(func(i interface{}) int {
+		return i.(foo).f1
+	})
+
+	if !validateQuery(q.Query, want) {
+		t.Errorf("From(%v).OrderByIntKey()=%v expected %v", input, toSlice(q.Query), want)
+	}
+}
+
+func TestOrderByIntKey_ThenBy(t *testing.T) {
+	input := []foo{{f1: 2, f3: "b"}, {f1: 1, f3: "c"}, {f1: 2, f3: "a"}, {f1: 1, f3: "a"}}
+	want := []interface{}{input[3], input[1], input[2], input[0]}
+
+	q := From(input).OrderByIntKey(func(i interface{}) int {
+		return i.(foo).f1
+	}).ThenBy(func(i interface{}) interface{} {
+		return i.(foo).f3
+	})
+
+	if !validateQuery(q.Query, want) {
+		t.Errorf("From(%v).OrderByIntKey().ThenBy()=%v expected %v", input, toSlice(q.Query), want)
+	}
+}
+
+func TestOrderByIntKeyT_PanicWhenKeySelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "OrderByIntKeyT: parameter [keySelectorFn] has a invalid function signature. Expected: 'func(T)int', actual: 'func(int)string'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).OrderByIntKeyT(func(item int) string { return "" })
+	})
+}
+
+func TestOrderByStringKey(t *testing.T) {
+	input := []foo{{f1: 1, f3: "b"}, {f1: 2, f3: "a"}, {f1: 3, f3: "b"}, {f1: 4, f3: "a"}}
+	want := []interface{}{input[1], input[3], input[0], input[2]}
+
+	q := From(input).OrderByStringKey(func(i interface{}) string {
+		return i.(foo).f3
+	})
+
+	if !validateQuery(q.Query, want) {
+		t.Errorf("From(%v).OrderByStringKey()=%v expected %v", input, toSlice(q.Query), want)
+	}
+}
+
+func TestOrderByStringKeyT_PanicWhenKeySelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "OrderByStringKeyT: parameter [keySelectorFn] has a invalid function signature. Expected: 'func(T)string', actual: 'func(int)int'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).OrderByStringKeyT(func(item int) int { return item })
+	})
+}
+
+func TestOrderByFloat64Key(t *testing.T) {
+	input := []float64{2.5, -1, 0.25, 10}
+	want := []interface{}{10.0, 2.5, 0.25, -1.0}
+
+	q := From(input).OrderByFloat64Key(func(i interface{}) float64 {
+		return -i.(float64)
+	})
+
+	if !validateQuery(q.Query, want) {
+		t.Errorf("From(%v).OrderByFloat64Key()=%v expected %v", input, toSlice(q.Query), want)
+	}
+}
+
+func TestOrderByFloat64KeyT_PanicWhenKeySelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "OrderByFloat64KeyT: parameter [keySelectorFn] has a invalid function signature. Expected: 'func(T)float64', actual: 'func(int)int'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).OrderByFloat64KeyT(func(item int) int { return item })
+	})
+}
+
+func TestOrderByTimeKey(t *testing.T) {
+	t1, t2, t3 := time.Unix(100, 0), time.Unix(200, 0), time.Unix(300, 0)
+	input := []time.Time{t3, t1, t2}
+	want := []interface{}{t1, t2, t3}
+
+	q := From(input).OrderByTimeKey(func(i interface{}) time.Time {
+		return i.(time.Time)
+	})
+
+	if !validateQuery(q.Query, want) {
+		t.Errorf("From(%v).OrderByTimeKey()=%v expected %v", input, toSlice(q.Query), want)
+	}
+
+	if r := From(input).OrderBy(func(i interface{}) interface{} { return i }).First(); r != t1 {
+		t.Errorf("From(%v).OrderBy().First()=%v expected %v", input, r, t1)
+	}
+}
+
+func TestOrderByTimeKeyT_PanicWhenKeySelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "OrderByTimeKeyT: parameter [keySelectorFn] has a invalid function signature. Expected: 'func(T)time.Time', actual: 'func(int)int'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).OrderByTimeKeyT(func(item int) int { return item })
+	})
+}
+
 func TestThenBy(t *testing.T) {
 	slice := make([]foo, 1000)
 
@@ -156,3 +327,33 @@ func TestSortT_PanicWhenLessFnIsInvalid(t *testing.T) {
 		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).SortT(func(i, j int) string { return "" })
 	})
 }
+
+func TestSortStable(t *testing.T) {
+	slice := make([]foo, 100)
+
+	for i := range slice {
+		slice[i].f1 = i
+		slice[i].f2 = i%3 == 0
+	}
+
+	q := From(slice).SortStable(func(i, j interface{}) bool {
+		return i.(foo).f2 && !j.(foo).f2
+	})
+
+	prev := foo{f1: -1, f2: true}
+	next := q.Iterate()
+	for item, ok := next(); ok; item, ok = next() {
+		f := item.(foo)
+		if f.f2 == prev.f2 && f.f1 < prev.f1 || f.f2 && !prev.f2 {
+			t.Errorf("SortStable()=%v placed after %v", f, prev)
+		}
+
+		prev = f
+	}
+}
+
+func TestSortStableT_PanicWhenLessFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "SortStableT: parameter [lessFn] has a invalid function signature. Expected: 'func(T,T)bool', actual: 'func(int,int)string'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).SortStableT(func(i, j int) string { return "" })
+	})
+}
@@ -0,0 +1,117 @@
+package linq
+
+import (
+	"runtime"
+	"sync"
+)
+
+// SelectParallelChunked projects each element of a collection into a new form
+// using several goroutines. The collection is split into chunks of chunkSize
+// contiguous elements, and the chunks are distributed across workers
+// goroutines. Processing a chunk at a time keeps the synchronization overhead
+// low for cheap selectors. The order of the elements is preserved in the
+// result.
+//
+// If workers is not positive, runtime.NumCPU() workers are used. If chunkSize
+// is not positive, every chunk contains a single element.
+//
+// SelectParallelChunked is not deferred: the source collection is enumerated
+// and projected when the method is called. If selector returns an error, no
+// further chunks are started and the first error is returned.
+func (q Query) SelectParallelChunked(selector func(interface{}) (interface{}, error),
+	workers, chunkSize int) (Query, error) {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	if chunkSize <= 0 {
+		chunkSize = 1
+	}
+
+	items := q.Results()
+	results := make([]interface{}, len(items))
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	done := make(chan struct{})
+	chunks := make(chan int)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for start := range chunks {
+				end := start + chunkSize
+				if end > len(items) {
+					end = len(items)
+				}
+
+				for i := start; i < end; i++ {
+					r, err := selector(items[i])
+					if err != nil {
+						once.Do(func() {
+							firstErr = err
+							close(done)
+						})
+						return
+					}
+
+					results[i] = r
+				}
+			}
+		}()
+	}
+
+feed:
+	for start := 0; start < len(items); start += chunkSize {
+		select {
+		case <-done:
+			break feed
+		default:
+		}
+
+		select {
+		case chunks <- start:
+		case <-done:
+			break feed
+		}
+	}
+
+	close(chunks)
+	wg.Wait()
+
+	if firstErr != nil {
+		return Query{}, firstErr
+	}
+
+	return From(results), nil
+}
+
+// SelectParallelChunkedT is the typed version of SelectParallelChunked.
+//
+//   - selectorFn is of type "func(TSource)(TResult,error)"
+//
+// NOTE: SelectParallelChunked has better performance than
+// SelectParallelChunkedT.
+func (q Query) SelectParallelChunkedT(selectorFn interface{},
+	workers, chunkSize int) (Query, error) {
+	selectorGenericFunc, err := newGenericFunc(
+		"SelectParallelChunkedT", "selectorFn", selectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType), new(error))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	selectorFunc := func(item interface{}) (interface{}, error) {
+		results := selectorGenericFunc.CallMulti(item)
+		err, _ := results[1].(error)
+		return results[0], err
+	}
+
+	return q.SelectParallelChunked(selectorFunc, workers, chunkSize)
+}
@@ -0,0 +1,61 @@
+package linq
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSelectParallelChunked(t *testing.T) {
+	tests := []struct {
+		input     interface{}
+		workers   int
+		chunkSize int
+		output    []interface{}
+	}{
+		{[]int{1, 2, 3, 4, 5, 6, 7}, 3, 2, []interface{}{2, 4, 6, 8, 10, 12, 14}},
+		{[]int{1, 2, 3}, 0, 0, []interface{}{2, 4, 6}},
+		{[]int{1, 2, 3}, 8, 10, []interface{}{2, 4, 6}},
+		{[]int{}, 2, 2, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		q, err := From(test.input).SelectParallelChunked(func(i interface{}) (interface{}, error) {
+			return i.(int) * 2, nil
+		}, test.workers, test.chunkSize)
+
+		if err != nil || !validateQuery(q, test.output) {
+			t.Errorf("From(%v).SelectParallelChunked(%d, %d)=%v, %v expected %v, <nil>", test.input, test.workers, test.chunkSize, toSlice(q), err, test.output)
+		}
+	}
+}
+
+func TestSelectParallelChunked_Error(t *testing.T) {
+	errOdd := errors.New("odd")
+
+	_, err := Range(0, 100).SelectParallelChunked(func(i interface{}) (interface{}, error) {
+		if i.(int) == 51 {
+			return nil, errOdd
+		}
+		return i, nil
+	}, 4, 5)
+
+	if err != errOdd {
+		t.Errorf("SelectParallelChunked() error=%v expected %v", err, errOdd)
+	}
+}
+
+func TestSelectParallelChunkedT(t *testing.T) {
+	q, err := From([]int{1, 2, 3}).SelectParallelChunkedT(func(i int) (string, error) {
+		return string(rune('a' + i)), nil
+	}, 2, 1)
+
+	if want := []interface{}{"b", "c", "d"}; err != nil || !validateQuery(q, want) {
+		t.Errorf("SelectParallelChunkedT()=%v, %v expected %v, <nil>", toSlice(q), err, want)
+	}
+}
+
+func TestSelectParallelChunkedT_PanicWhenSelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "SelectParallelChunkedT: parameter [selectorFn] has a invalid function signature. Expected: 'func(T)T,error', actual: 'func(int)int'", func() {
+		From([]int{1, 2, 3}).SelectParallelChunkedT(func(item int) int { return item + 2 }, 2, 1)
+	})
+}
@@ -0,0 +1,157 @@
+package linq
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+)
+
+// TakeRandom returns n distinct elements picked at random from a collection,
+// in random order. If n is greater than the number of elements, all the
+// elements are returned, shuffled. If n is not positive, the result is an empty
+// collection.
+//
+// The random numbers are drawn from rng. If rng is nil, the default source of
+// the math/rand package is used. TakeRandom buffers the collection, but the
+// source collection itself is never modified.
+//
+// The sample is drawn once, the first time the result is enumerated, and every
+// later enumeration returns the same sample. rng is only used while the sample
+// is drawn, so the result can be enumerated concurrently.
+func (q Query) TakeRandom(n int, rng *rand.Rand) Query {
+	intn := rand.Intn
+	if rng != nil {
+		intn = rng.Intn
+	}
+
+	var (
+		once  sync.Once
+		items []interface{}
+		count int
+	)
+
+	return Query{
+		Iterate: func() Iterator {
+			once.Do(func() {
+				items = q.Results()
+
+				count = n
+				if count > len(items) {
+					count = len(items)
+				}
+
+				// partial Fisher-Yates shuffle: only the first count positions
+				// are drawn.
+				for i := 0; i < count; i++ {
+					j := i + intn(len(items)-i)
+					items[i], items[j] = items[j], items[i]
+				}
+			})
+
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				ok = index < count
+				if ok {
+					item = items[index]
+					index++
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// WeightedSample returns n distinct elements picked at random from a
+// collection, without replacement. The probability of picking an element is
+// proportional to its weight, as returned by function weight. Elements with a
+// weight that is not positive are never picked, so fewer than n elements are
+// returned if fewer elements have a positive weight. If n is not positive, the
+// result is an empty collection.
+//
+// The random numbers are drawn from rng. If rng is nil, the default source of
+// the math/rand package is used.
+//
+// The sample is drawn once, the first time the result is enumerated, and every
+// later enumeration returns the same sample. rng is only used while the sample
+// is drawn, so the result can be enumerated concurrently.
+func (q Query) WeightedSample(n int, weight func(interface{}) float64, rng *rand.Rand) Query {
+	float64n := rand.Float64
+	if rng != nil {
+		float64n = rng.Float64
+	}
+
+	var (
+		once  sync.Once
+		pairs []struct {
+			key  float64
+			item interface{}
+		}
+		count int
+	)
+
+	return Query{
+		Iterate: func() Iterator {
+			once.Do(func() {
+				// every element gets the key u^(1/w), where u is uniform in
+				// [0, 1), and the elements with the largest keys are picked.
+				next := q.Iterate()
+				for item, ok := next(); ok; item, ok = next() {
+					w := weight(item)
+					if !(w > 0) {
+						continue
+					}
+
+					pairs = append(pairs, struct {
+						key  float64
+						item interface{}
+					}{math.Pow(float64n(), 1/w), item})
+				}
+
+				sort.Slice(pairs, func(i, j int) bool {
+					return pairs[i].key > pairs[j].key
+				})
+
+				count = n
+				if count > len(pairs) {
+					count = len(pairs)
+				}
+			})
+
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				ok = index < count
+				if ok {
+					item = pairs[index].item
+					index++
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// WeightedSampleT is the typed version of WeightedSample.
+//
+//   - weightFn is of type "func(TSource)float64"
+//
+// NOTE: WeightedSample has better performance than WeightedSampleT.
+func (q Query) WeightedSampleT(n int, weightFn interface{}, rng *rand.Rand) Query {
+	weightGenericFunc, err := newGenericFunc(
+		"WeightedSampleT", "weightFn", weightFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(float64))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	weightFunc := func(item interface{}) float64 {
+		return weightGenericFunc.Call(item).(float64)
+	}
+
+	return q.WeightedSample(n, weightFunc, rng)
+}
@@ -0,0 +1,137 @@
+package linq
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestTakeRandom(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6, 7, 8}
+
+	tests := []struct {
+		n    int
+		want int
+	}{
+		{3, 3},
+		{8, 8},
+		{20, 8},
+		{0, 0},
+		{-1, 0},
+	}
+
+	for _, test := range tests {
+		r := From(input).TakeRandom(test.n, rand.New(rand.NewSource(1))).Results()
+
+		if len(r) != test.want {
+			t.Errorf("TakeRandom(%d) returned %d elements expected %d", test.n, len(r), test.want)
+		}
+
+		if From(r).Distinct().Count() != len(r) || From(r).Except(From(input)).Any() {
+			t.Errorf("TakeRandom(%d)=%v is not a subset of distinct source elements", test.n, r)
+		}
+	}
+
+	if !validateQuery(From(input), []interface{}{1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Errorf("TakeRandom() modified the source to %v", input)
+	}
+}
+
+func TestTakeRandom_Seeded(t *testing.T) {
+	q := Range(0, 100)
+
+	r1 := q.TakeRandom(10, rand.New(rand.NewSource(42))).Results()
+	r2 := q.TakeRandom(10, rand.New(rand.NewSource(42))).Results()
+
+	if !From(r1).SequenceEqual(From(r2)) {
+		t.Errorf("TakeRandom() with equal seeds returned %v and %v", r1, r2)
+	}
+
+	if r := q.TakeRandom(5, nil).Count(); r != 5 {
+		t.Errorf("TakeRandom(5, nil) returned %d elements expected 5", r)
+	}
+}
+
+func TestTakeRandom_SameSampleOnEveryEnumeration(t *testing.T) {
+	q := Range(0, 100).TakeRandom(10, rand.New(rand.NewSource(7)))
+	want := q.Results()
+
+	done := make(chan []interface{})
+	for i := 0; i < 4; i++ {
+		go func() { done <- q.Results() }()
+	}
+
+	for i := 0; i < 4; i++ {
+		if r := <-done; !From(r).SequenceEqual(From(want)) {
+			t.Errorf("TakeRandom() enumerated again returned %v expected %v", r, want)
+		}
+	}
+}
+
+func TestWeightedSample(t *testing.T) {
+	weight := func(i interface{}) float64 {
+		return float64(i.(int))
+	}
+
+	tests := []struct {
+		input interface{}
+		n     int
+		want  int
+	}{
+		{[]int{1, 2, 3, 4}, 2, 2},
+		{[]int{1, 2, 3, 4}, 10, 4},
+		{[]int{0, -1, 3, 0}, 3, 1},
+		{[]int{1, 2}, 0, 0},
+	}
+
+	for _, test := range tests {
+		r := From(test.input).WeightedSample(test.n, weight, rand.New(rand.NewSource(1))).Results()
+
+		if len(r) != test.want || From(r).Distinct().Count() != len(r) {
+			t.Errorf("From(%v).WeightedSample(%d)=%v expected %d distinct elements", test.input, test.n, r, test.want)
+		}
+
+		if From(r).AnyWith(func(i interface{}) bool { return weight(i) <= 0 }) {
+			t.Errorf("From(%v).WeightedSample(%d)=%v picked an element without weight", test.input, test.n, r)
+		}
+	}
+}
+
+func TestWeightedSample_Distribution(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	weights := map[interface{}]float64{"rare": 1, "common": 9}
+	picks := map[interface{}]int{}
+
+	for i := 0; i < 1000; i++ {
+		picks[From([]string{"rare", "common"}).WeightedSample(1, func(i interface{}) float64 {
+			return weights[i]
+		}, rng).First()]++
+	}
+
+	if picks["common"] < 850 || picks["common"] > 950 {
+		t.Errorf("WeightedSample() picked the 90%% element %d times out of 1000", picks["common"])
+	}
+}
+
+func TestWeightedSample_SameSampleOnEveryEnumeration(t *testing.T) {
+	q := Range(1, 100).WeightedSample(10, func(i interface{}) float64 {
+		return float64(i.(int))
+	}, rand.New(rand.NewSource(7)))
+	want := q.Results()
+
+	done := make(chan []interface{})
+	for i := 0; i < 4; i++ {
+		go func() { done <- q.Results() }()
+	}
+
+	for i := 0; i < 4; i++ {
+		if r := <-done; !From(r).SequenceEqual(From(want)) {
+			t.Errorf("WeightedSample() enumerated again returned %v expected %v", r, want)
+		}
+	}
+}
+
+func TestWeightedSampleT_PanicWhenWeightFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "WeightedSampleT: parameter [weightFn] has a invalid function signature. Expected: 'func(T)float64', actual: 'func(int)int'", func() {
+		From([]int{1, 2, 3}).WeightedSampleT(1, func(item int) int { return item }, nil)
+	})
+}
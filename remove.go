@@ -0,0 +1,33 @@
+package linq
+
+// RemoveAt returns the elements of a collection except the one at the
+// specified zero-based index. If index is negative or not less than the number
+// of elements in the collection, all the elements are returned.
+func (q Query) RemoveAt(index int) Query {
+	return q.RemoveRange(index, 1)
+}
+
+// RemoveRange returns the elements of a collection except a range of count
+// elements starting at the specified zero-based index. If start is negative or
+// count is not positive, all the elements are returned. If the range extends
+// past the end of the collection, only the elements before start are returned.
+func (q Query) RemoveRange(start, count int) Query {
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				for item, ok = next(); ok; item, ok = next() {
+					removed := start >= 0 && index >= start && index-start < count
+					index++
+					if !removed {
+						return
+					}
+				}
+
+				return
+			}
+		},
+	}
+}
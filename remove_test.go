@@ -0,0 +1,55 @@
+package linq
+
+import "testing"
+
+func TestRemoveAt(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		index  int
+		output []interface{}
+	}{
+		{[]int{1, 2, 3}, 0, []interface{}{2, 3}},
+		{[]int{1, 2, 3}, 1, []interface{}{1, 3}},
+		{[]int{1, 2, 3}, 2, []interface{}{1, 2}},
+		{[]int{1, 2, 3}, 3, []interface{}{1, 2, 3}},
+		{[]int{1, 2, 3}, -1, []interface{}{1, 2, 3}},
+		{"sstr", 1, []interface{}{'s', 't', 'r'}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).RemoveAt(test.index); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).RemoveAt(%v)=%v expected %v", test.input, test.index, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestRemoveRange(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		start  int
+		count  int
+		output []interface{}
+	}{
+		{[]int{1, 2, 3, 4, 5}, 1, 2, []interface{}{1, 4, 5}},
+		{[]int{1, 2, 3, 4, 5}, 0, 5, []interface{}{}},
+		{[]int{1, 2, 3, 4, 5}, 3, 10, []interface{}{1, 2, 3}},
+		{[]int{1, 2, 3}, 1, 0, []interface{}{1, 2, 3}},
+		{[]int{1, 2, 3}, -1, 2, []interface{}{1, 2, 3}},
+		{[]int{1, 2, 3}, 5, 1, []interface{}{1, 2, 3}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).RemoveRange(test.start, test.count); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).RemoveRange(%v, %v)=%v expected %v", test.input, test.start, test.count, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestRemoveRange_DoesNotModifySource(t *testing.T) {
+	input := []int{1, 2, 3}
+	From(input).RemoveRange(0, 2).Results()
+
+	if input[0] != 1 || input[1] != 2 || input[2] != 3 {
+		t.Errorf("From([1 2 3]).RemoveRange() modified the source: %v", input)
+	}
+}
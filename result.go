@@ -1,8 +1,11 @@
 package linq
 
 import (
+	"fmt"
 	"math"
 	"reflect"
+	"strings"
+	"time"
 )
 
 // All determines whether all elements of a collection satisfy a condition.
@@ -80,6 +83,93 @@ func (q Query) AnyWithT(predicateFn interface{}) bool {
 	return q.AnyWith(predicateFunc)
 }
 
+// AtLeast determines whether at least n elements of a collection satisfy a
+// condition. The enumeration stops as soon as the n-th such element is found.
+func (q Query) AtLeast(n int, predicate func(interface{}) bool) bool {
+	if n <= 0 {
+		return true
+	}
+
+	next := q.Iterate()
+	count := 0
+
+	for item, ok := next(); ok; item, ok = next() {
+		if predicate(item) {
+			count++
+			if count >= n {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// AtLeastT is the typed version of AtLeast.
+//
+//   - predicateFn is of type "func(TSource) bool"
+//
+// NOTE: AtLeast has better performance than AtLeastT.
+func (q Query) AtLeastT(n int, predicateFn interface{}) bool {
+	predicateGenericFunc, err := newGenericFunc(
+		"AtLeastT", "predicateFn", predicateFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	predicateFunc := func(item interface{}) bool {
+		return predicateGenericFunc.Call(item).(bool)
+	}
+
+	return q.AtLeast(n, predicateFunc)
+}
+
+// AtMost determines whether at most n elements of a collection satisfy a
+// condition. The enumeration stops as soon as the (n+1)-th such element is
+// found. If n is negative, AtMost returns false.
+func (q Query) AtMost(n int, predicate func(interface{}) bool) bool {
+	if n < 0 {
+		return false
+	}
+
+	next := q.Iterate()
+	count := 0
+
+	for item, ok := next(); ok; item, ok = next() {
+		if predicate(item) {
+			count++
+			if count > n {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// AtMostT is the typed version of AtMost.
+//
+//   - predicateFn is of type "func(TSource) bool"
+//
+// NOTE: AtMost has better performance than AtMostT.
+func (q Query) AtMostT(n int, predicateFn interface{}) bool {
+	predicateGenericFunc, err := newGenericFunc(
+		"AtMostT", "predicateFn", predicateFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	predicateFunc := func(item interface{}) bool {
+		return predicateGenericFunc.Call(item).(bool)
+	}
+
+	return q.AtMost(n, predicateFunc)
+}
+
 // Average computes the average of a collection of numeric values.
 func (q Query) Average() (r float64) {
 	next := q.Iterate()
@@ -137,6 +227,9 @@ func (q Query) Contains(value interface{}) bool {
 }
 
 // Count returns the number of elements in a collection.
+//
+// Count consumes the collection one element at a time and never buffers it, so
+// it is safe to use on streaming sources such as channels.
 func (q Query) Count() (r int) {
 	next := q.Iterate()
 
@@ -147,6 +240,70 @@ func (q Query) Count() (r int) {
 	return
 }
 
+// CountUntil returns the number of elements at the start of a collection that
+// come before the first element satisfying a condition. The enumeration stops
+// at that element.
+func (q Query) CountUntil(predicate func(interface{}) bool) int {
+	return q.CountWhile(func(item interface{}) bool {
+		return !predicate(item)
+	})
+}
+
+// CountUntilT is the typed version of CountUntil.
+//
+//   - predicateFn is of type "func(TSource)bool"
+//
+// NOTE: CountUntil has better performance than CountUntilT.
+func (q Query) CountUntilT(predicateFn interface{}) int {
+	predicateGenericFunc, err := newGenericFunc(
+		"CountUntilT", "predicateFn", predicateFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	predicateFunc := func(item interface{}) bool {
+		return predicateGenericFunc.Call(item).(bool)
+	}
+
+	return q.CountUntil(predicateFunc)
+}
+
+// CountWhile returns the number of consecutive elements at the start of a
+// collection that satisfy a condition. The enumeration stops at the first
+// element that doesn't satisfy it, and no intermediate collection is built.
+func (q Query) CountWhile(predicate func(interface{}) bool) (r int) {
+	next := q.Iterate()
+
+	for item, ok := next(); ok && predicate(item); item, ok = next() {
+		r++
+	}
+
+	return
+}
+
+// CountWhileT is the typed version of CountWhile.
+//
+//   - predicateFn is of type "func(TSource)bool"
+//
+// NOTE: CountWhile has better performance than CountWhileT.
+func (q Query) CountWhileT(predicateFn interface{}) int {
+	predicateGenericFunc, err := newGenericFunc(
+		"CountWhileT", "predicateFn", predicateFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	predicateFunc := func(item interface{}) bool {
+		return predicateGenericFunc.Call(item).(bool)
+	}
+
+	return q.CountWhile(predicateFunc)
+}
+
 // CountWith returns a number that represents how many elements in the specified
 // collection satisfy a condition.
 func (q Query) CountWith(predicate func(interface{}) bool) (r int) {
@@ -183,6 +340,50 @@ func (q Query) CountWithT(predicateFn interface{}) int {
 	return q.CountWith(predicateFunc)
 }
 
+// Exactly determines whether exactly n elements of a collection satisfy a
+// condition. The enumeration stops as soon as the (n+1)-th such element is
+// found. If n is negative, Exactly returns false.
+func (q Query) Exactly(n int, predicate func(interface{}) bool) bool {
+	if n < 0 {
+		return false
+	}
+
+	next := q.Iterate()
+	count := 0
+
+	for item, ok := next(); ok; item, ok = next() {
+		if predicate(item) {
+			count++
+			if count > n {
+				return false
+			}
+		}
+	}
+
+	return count == n
+}
+
+// ExactlyT is the typed version of Exactly.
+//
+//   - predicateFn is of type "func(TSource) bool"
+//
+// NOTE: Exactly has better performance than ExactlyT.
+func (q Query) ExactlyT(n int, predicateFn interface{}) bool {
+	predicateGenericFunc, err := newGenericFunc(
+		"ExactlyT", "predicateFn", predicateFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	predicateFunc := func(item interface{}) bool {
+		return predicateGenericFunc.Call(item).(bool)
+	}
+
+	return q.Exactly(n, predicateFunc)
+}
+
 // First returns the first element of a collection.
 func (q Query) First() interface{} {
 	item, _ := q.Iterate()()
@@ -225,6 +426,49 @@ func (q Query) FirstWithT(predicateFn interface{}) interface{} {
 	return q.FirstWith(predicateFunc)
 }
 
+// Frequencies returns a map of the distinct elements of a collection to the
+// number of their occurrences.
+func (q Query) Frequencies() map[interface{}]int {
+	return q.FrequenciesBy(func(item interface{}) interface{} {
+		return item
+	})
+}
+
+// FrequenciesBy returns a map of the distinct keys of a collection to the
+// number of elements having each key. Function selector is executed for each
+// element of the collection to generate its key.
+func (q Query) FrequenciesBy(selector func(interface{}) interface{}) map[interface{}]int {
+	next := q.Iterate()
+	counts := make(map[interface{}]int)
+
+	for item, ok := next(); ok; item, ok = next() {
+		counts[selector(item)]++
+	}
+
+	return counts
+}
+
+// FrequenciesByT is the typed version of FrequenciesBy.
+//
+//   - selectorFn is of type "func(TSource) TKey"
+//
+// NOTE: FrequenciesBy has better performance than FrequenciesByT.
+func (q Query) FrequenciesByT(selectorFn interface{}) map[interface{}]int {
+	selectorGenericFunc, err := newGenericFunc(
+		"FrequenciesByT", "selectorFn", selectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	selectorFunc := func(item interface{}) interface{} {
+		return selectorGenericFunc.Call(item)
+	}
+
+	return q.FrequenciesBy(selectorFunc)
+}
+
 // ForEach performs the specified action on each element of a collection.
 func (q Query) ForEach(action func(interface{})) {
 	next := q.Iterate()
@@ -256,6 +500,39 @@ func (q Query) ForEachT(actionFn interface{}) {
 	q.ForEach(actionFunc)
 }
 
+// ForEachChunk splits a collection into chunks of size elements and performs
+// the specified action on each chunk, in order. The last chunk can contain
+// fewer than size elements.
+//
+// Only one chunk is held in memory at a time. The enumeration stops at the
+// first error returned by action, and that error is returned. If size is not
+// positive, action is never invoked.
+func (q Query) ForEachChunk(size int, action func([]interface{}) error) error {
+	if size <= 0 {
+		return nil
+	}
+
+	next := q.Iterate()
+	var chunk []interface{}
+
+	for item, ok := next(); ok; item, ok = next() {
+		chunk = append(chunk, item)
+		if len(chunk) == size {
+			if err := action(chunk); err != nil {
+				return err
+			}
+
+			chunk = nil
+		}
+	}
+
+	if len(chunk) > 0 {
+		return action(chunk)
+	}
+
+	return nil
+}
+
 // ForEachIndexed performs the specified action on each element of a collection.
 //
 // The first argument to action represents the zero-based index of that
@@ -296,15 +573,268 @@ func (q Query) ForEachIndexedT(actionFn interface{}) {
 	q.ForEachIndexed(actionFunc)
 }
 
+// ForEachTimeout performs the specified action on each element of a
+// collection, in order, and waits at most perItem for each invocation of action
+// to complete.
+//
+// The enumeration stops at the first error returned by action, and that error
+// is returned. If an invocation takes longer than perItem, an error wrapping
+// ErrTimeout is returned without waiting for it; the invocation keeps running in
+// its own goroutine until action returns.
+func (q Query) ForEachTimeout(action func(interface{}) error, perItem time.Duration) error {
+	next := q.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		done := make(chan error, 1)
+		go func(item interface{}) {
+			done <- action(item)
+		}(item)
+
+		timer := time.NewTimer(perItem)
+		select {
+		case err := <-done:
+			timer.Stop()
+			if err != nil {
+				return err
+			}
+		case <-timer.C:
+			return fmt.Errorf("ForEachTimeout: %w: action on %v exceeded %v", ErrTimeout, item, perItem)
+		}
+	}
+
+	return nil
+}
+
+// Histogram counts the numeric elements of a collection that fall into each of
+// bins equal-width bins covering the range [min, max]. Bin i covers the values
+// from min+(max-min)*i/bins, included, to the start of the next bin, excluded,
+// so a value on the edge between two bins is counted in the second one. A value
+// equal to max is counted in the last bin.
+//
+// If clamp is true, values below min are counted in the first bin and values
+// above max in the last one. Otherwise they are not counted in any bin, and
+// outside reports how many of them there are. Elements that are not numeric,
+// or are NaN, are always reported in outside. If bins is not positive, or if
+// max is not greater than min, counts is nil and every element is reported in
+// outside.
+func (q Query) Histogram(min, max float64, bins int, clamp bool) (counts []int, outside int) {
+	next := q.Iterate()
+
+	if bins <= 0 || !(max > min) {
+		for _, ok := next(); ok; _, ok = next() {
+			outside++
+		}
+
+		return nil, outside
+	}
+
+	counts = make([]int, bins)
+	edge := func(bin int) float64 {
+		return min + (max-min)*float64(bin)/float64(bins)
+	}
+
+	for item, ok := next(); ok; item, ok = next() {
+		f, ok := toFloat64(item)
+		if !ok || math.IsNaN(f) || (!clamp && (f < min || f > max)) {
+			outside++
+			continue
+		}
+
+		var bin int
+		switch {
+		case f < min:
+			bin = 0
+		case f >= max:
+			bin = bins - 1
+		default:
+			bin = int((f - min) / (max - min) * float64(bins))
+			if bin >= bins {
+				bin = bins - 1
+			}
+
+			// the division can round a value that sits on a bin edge into
+			// the previous or the next bin, so the bin is checked against
+			// its edges.
+			if bin > 0 && f < edge(bin) {
+				bin--
+			} else if bin < bins-1 && f >= edge(bin+1) {
+				bin++
+			}
+		}
+
+		counts[bin]++
+	}
+
+	return counts, outside
+}
+
+// IndexOf searches for the specified element and returns the zero-based index
+// of its first occurrence in a collection, or -1 if the collection doesn't
+// contain it.
+func (q Query) IndexOf(value interface{}) int {
+	next := q.Iterate()
+	index := 0
+
+	for item, ok := next(); ok; item, ok = next() {
+		if item == value {
+			return index
+		}
+
+		index++
+	}
+
+	return -1
+}
+
+// IndexOfWith returns the zero-based index of the first element of a
+// collection that satisfies a specified condition, or -1 if there is no such
+// element.
+func (q Query) IndexOfWith(predicate func(interface{}) bool) int {
+	next := q.Iterate()
+	index := 0
+
+	for item, ok := next(); ok; item, ok = next() {
+		if predicate(item) {
+			return index
+		}
+
+		index++
+	}
+
+	return -1
+}
+
+// IndexOfWithT is the typed version of IndexOfWith.
+//
+//   - predicateFn is of type "func(TSource) bool"
+//
+// NOTE: IndexOfWith has better performance than IndexOfWithT.
+func (q Query) IndexOfWithT(predicateFn interface{}) int {
+	predicateGenericFunc, err := newGenericFunc(
+		"IndexOfWithT", "predicateFn", predicateFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	predicateFunc := func(item interface{}) bool {
+		return predicateGenericFunc.Call(item).(bool)
+	}
+
+	return q.IndexOfWith(predicateFunc)
+}
+
+// IsSubsetOf determines whether every element of a collection is also an
+// element of q2. The enumeration of the collection stops at the first element
+// that is missing from q2. An empty collection is a subset of any collection.
+func (q Query) IsSubsetOf(q2 Query) bool {
+	set := q2.ToSet()
+
+	next := q.Iterate()
+	for item, ok := next(); ok; item, ok = next() {
+		if _, has := set[item]; !has {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSupersetOf determines whether every element of q2 is also an element of
+// a collection. The enumeration of q2 stops at the first element that is
+// missing from the collection.
+func (q Query) IsSupersetOf(q2 Query) bool {
+	return q2.IsSubsetOf(q)
+}
+
+// JoinString concatenates the elements of a collection, which must be strings,
+// placing sep between them. An empty collection results in an empty string. An
+// error wrapping ErrTypeMismatch is returned if an element is not a string.
+func (q Query) JoinString(sep string) (string, error) {
+	next := q.Iterate()
+	var r []string
+
+	for item, ok := next(); ok; item, ok = next() {
+		s, ok := item.(string)
+		if !ok {
+			return "", fmt.Errorf("JoinString: %w: element %d is a %T", ErrTypeMismatch, len(r), item)
+		}
+
+		r = append(r, s)
+	}
+
+	return strings.Join(r, sep), nil
+}
+
 // Last returns the last element of a collection.
 func (q Query) Last() (r interface{}) {
 	next := q.Iterate()
 
-	for item, ok := next(); ok; item, ok = next() {
-		r = item
+	for item, ok := next(); ok; item, ok = next() {
+		r = item
+	}
+
+	return
+}
+
+// LastIndexOf searches for the specified element and returns the zero-based
+// index of its last occurrence in a collection, or -1 if the collection doesn't
+// contain it.
+func (q Query) LastIndexOf(value interface{}) (r int) {
+	next := q.Iterate()
+	index := 0
+	r = -1
+
+	for item, ok := next(); ok; item, ok = next() {
+		if item == value {
+			r = index
+		}
+
+		index++
+	}
+
+	return
+}
+
+// LastIndexOfWith returns the zero-based index of the last element of a
+// collection that satisfies a specified condition, or -1 if there is no such
+// element.
+func (q Query) LastIndexOfWith(predicate func(interface{}) bool) (r int) {
+	next := q.Iterate()
+	index := 0
+	r = -1
+
+	for item, ok := next(); ok; item, ok = next() {
+		if predicate(item) {
+			r = index
+		}
+
+		index++
+	}
+
+	return
+}
+
+// LastIndexOfWithT is the typed version of LastIndexOfWith.
+//
+//   - predicateFn is of type "func(TSource) bool"
+//
+// NOTE: LastIndexOfWith has better performance than LastIndexOfWithT.
+func (q Query) LastIndexOfWithT(predicateFn interface{}) int {
+	predicateGenericFunc, err := newGenericFunc(
+		"LastIndexOfWithT", "predicateFn", predicateFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	predicateFunc := func(item interface{}) bool {
+		return predicateGenericFunc.Call(item).(bool)
 	}
 
-	return
+	return q.LastIndexOfWith(predicateFunc)
 }
 
 // LastWith returns the last element of a collection that satisfies a specified
@@ -343,7 +873,8 @@ func (q Query) LastWithT(predicateFn interface{}) interface{} {
 	return q.LastWith(predicateFunc)
 }
 
-// Max returns the maximum value in a collection of values.
+// Max returns the maximum value in a collection of values. It returns nil if
+// the collection contains no elements.
 func (q Query) Max() (r interface{}) {
 	next := q.Iterate()
 	item, ok := next()
@@ -363,7 +894,78 @@ func (q Query) Max() (r interface{}) {
 	return
 }
 
-// Min returns the minimum value in a collection of values.
+// MaxElementBy returns the element of a collection with the largest key, along
+// with that key. Function keySelector is executed exactly once for each element
+// to generate its key, and keys are compared with the less function, which
+// should return true if key a is less than key b. If several elements share
+// the largest key, the first one is returned.
+//
+// MaxElementBy returns nil for both the element and the key if the collection
+// contains no elements.
+func (q Query) MaxElementBy(keySelector func(interface{}) interface{},
+	less func(a, b interface{}) bool) (elem interface{}, key interface{}) {
+	next := q.Iterate()
+	item, ok := next()
+	if !ok {
+		return nil, nil
+	}
+
+	elem, key = item, keySelector(item)
+
+	for item, ok := next(); ok; item, ok = next() {
+		k := keySelector(item)
+		if less(key, k) {
+			elem, key = item, k
+		}
+	}
+
+	return
+}
+
+// MaxElementByT is the typed version of MaxElementBy.
+//
+//   - keySelectorFn is of type "func(TSource) TKey"
+//   - lessFn is of type "func(TKey, TKey) bool"
+//
+// NOTE: MaxElementBy has better performance than MaxElementByT.
+func (q Query) MaxElementByT(keySelectorFn interface{},
+	lessFn interface{}) (elem interface{}, key interface{}) {
+	keySelectorGenericFunc, err := newGenericFunc(
+		"MaxElementByT", "keySelectorFn", keySelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	keySelectorFunc := func(item interface{}) interface{} {
+		return keySelectorGenericFunc.Call(item)
+	}
+
+	lessGenericFunc, err := newGenericFunc(
+		"MaxElementByT", "lessFn", lessFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType), new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	lessFunc := func(a, b interface{}) bool {
+		return lessGenericFunc.Call(a, b).(bool)
+	}
+
+	return q.MaxElementBy(keySelectorFunc, lessFunc)
+}
+
+// MaxTime returns the latest time.Time in a collection. An error wrapping
+// ErrTypeMismatch is returned if an element is not a time.Time, and
+// ErrEmptySequence is returned if the collection is empty.
+func (q Query) MaxTime() (time.Time, error) {
+	return q.extremeTime("MaxTime", time.Time.After)
+}
+
+// Min returns the minimum value in a collection of values. It returns nil if
+// the collection contains no elements.
 func (q Query) Min() (r interface{}) {
 	next := q.Iterate()
 	item, ok := next()
@@ -383,7 +985,161 @@ func (q Query) Min() (r interface{}) {
 	return
 }
 
+// MinElementBy returns the element of a collection with the smallest key, along
+// with that key. Function keySelector is executed exactly once for each element
+// to generate its key, and keys are compared with the less function, which
+// should return true if key a is less than key b. If several elements share
+// the smallest key, the first one is returned.
+//
+// MinElementBy returns nil for both the element and the key if the collection
+// contains no elements.
+func (q Query) MinElementBy(keySelector func(interface{}) interface{},
+	less func(a, b interface{}) bool) (elem interface{}, key interface{}) {
+	next := q.Iterate()
+	item, ok := next()
+	if !ok {
+		return nil, nil
+	}
+
+	elem, key = item, keySelector(item)
+
+	for item, ok := next(); ok; item, ok = next() {
+		k := keySelector(item)
+		if less(k, key) {
+			elem, key = item, k
+		}
+	}
+
+	return
+}
+
+// MinElementByT is the typed version of MinElementBy.
+//
+//   - keySelectorFn is of type "func(TSource) TKey"
+//   - lessFn is of type "func(TKey, TKey) bool"
+//
+// NOTE: MinElementBy has better performance than MinElementByT.
+func (q Query) MinElementByT(keySelectorFn interface{},
+	lessFn interface{}) (elem interface{}, key interface{}) {
+	keySelectorGenericFunc, err := newGenericFunc(
+		"MinElementByT", "keySelectorFn", keySelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	keySelectorFunc := func(item interface{}) interface{} {
+		return keySelectorGenericFunc.Call(item)
+	}
+
+	lessGenericFunc, err := newGenericFunc(
+		"MinElementByT", "lessFn", lessFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType), new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	lessFunc := func(a, b interface{}) bool {
+		return lessGenericFunc.Call(a, b).(bool)
+	}
+
+	return q.MinElementBy(keySelectorFunc, lessFunc)
+}
+
+// MinTime returns the earliest time.Time in a collection. An error wrapping
+// ErrTypeMismatch is returned if an element is not a time.Time, and
+// ErrEmptySequence is returned if the collection is empty.
+func (q Query) MinTime() (time.Time, error) {
+	return q.extremeTime("MinTime", time.Time.Before)
+}
+
+// Mode returns the most frequent element of a collection along with the number
+// of its occurrences. If several elements are equally frequent, the one that
+// occurs first in the collection is returned.
+//
+// Mode returns nil and zero if the collection contains no elements.
+func (q Query) Mode() (r interface{}, count int) {
+	next := q.Iterate()
+	counts := make(map[interface{}]int)
+	var order []interface{}
+
+	for item, ok := next(); ok; item, ok = next() {
+		if _, has := counts[item]; !has {
+			order = append(order, item)
+		}
+
+		counts[item]++
+	}
+
+	for _, item := range order {
+		if counts[item] > count {
+			r, count = item, counts[item]
+		}
+	}
+
+	return
+}
+
+// Product computes the product of a collection of numeric values.
+//
+// Values can be of any integer, unsigned integer or float type. The result is
+// float64. Method returns one if collection contains no elements, and NaN if
+// any element is not numeric.
+func (q Query) Product() float64 {
+	return q.ProductBy(func(item interface{}) interface{} {
+		return item
+	})
+}
+
+// ProductBy computes the product of the numeric values obtained by invoking a
+// selector function on each element of a collection.
+//
+// Selected values can be of any integer, unsigned integer or float type. The
+// result is float64. Method returns one if collection contains no elements,
+// and NaN if any selected value is not numeric.
+func (q Query) ProductBy(selector func(interface{}) interface{}) (r float64) {
+	next := q.Iterate()
+	r = 1
+
+	for item, ok := next(); ok; item, ok = next() {
+		v, numeric := toFloat64(selector(item))
+		if !numeric {
+			return math.NaN()
+		}
+
+		r *= v
+	}
+
+	return
+}
+
+// ProductByT is the typed version of ProductBy.
+//
+//   - selectorFn is of type "func(TSource) TNumber"
+//
+// NOTE: ProductBy has better performance than ProductByT.
+func (q Query) ProductByT(selectorFn interface{}) float64 {
+	selectorGenericFunc, err := newGenericFunc(
+		"ProductByT", "selectorFn", selectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	selectorFunc := func(item interface{}) interface{} {
+		return selectorGenericFunc.Call(item)
+	}
+
+	return q.ProductBy(selectorFunc)
+}
+
 // Results iterates over a collection and returnes slice of interfaces
+//
+// The returned slice is always newly allocated, so it can be modified without
+// affecting the source collection or other queries built on it.
 func (q Query) Results() (r []interface{}) {
 	next := q.Iterate()
 
@@ -410,6 +1166,26 @@ func (q Query) SequenceEqual(q2 Query) bool {
 	return !ok2
 }
 
+// SetEqual determines whether two collections contain the same distinct
+// elements. Unlike SequenceEqual, the order of the elements and the number of
+// times an element occurs are ignored.
+func (q Query) SetEqual(q2 Query) bool {
+	set := q.ToSet()
+	set2 := q2.ToSet()
+
+	if len(set) != len(set2) {
+		return false
+	}
+
+	for item := range set {
+		if _, has := set2[item]; !has {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Single returns the only element of a collection, and nil if there is not
 // exactly one element in the collection.
 func (q Query) Single() interface{} {
@@ -427,6 +1203,54 @@ func (q Query) Single() interface{} {
 	return item
 }
 
+// SingleOrError returns the only element of a collection that satisfies a
+// specified condition. Unlike SingleWith, it reports why there is no such
+// element: ErrNoElement is returned if no element satisfies the condition, and
+// ErrMultipleElements if more than one does. The enumeration stops as soon as a
+// second matching element is found.
+func (q Query) SingleOrError(predicate func(interface{}) bool) (r interface{}, err error) {
+	next := q.Iterate()
+	found := false
+
+	for item, ok := next(); ok; item, ok = next() {
+		if predicate(item) {
+			if found {
+				return nil, ErrMultipleElements
+			}
+
+			found = true
+			r = item
+		}
+	}
+
+	if !found {
+		return nil, ErrNoElement
+	}
+
+	return r, nil
+}
+
+// SingleOrErrorT is the typed version of SingleOrError.
+//
+//   - predicateFn is of type "func(TSource) bool"
+//
+// NOTE: SingleOrError has better performance than SingleOrErrorT.
+func (q Query) SingleOrErrorT(predicateFn interface{}) (interface{}, error) {
+	predicateGenericFunc, err := newGenericFunc(
+		"SingleOrErrorT", "predicateFn", predicateFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	predicateFunc := func(item interface{}) bool {
+		return predicateGenericFunc.Call(item).(bool)
+	}
+
+	return q.SingleOrError(predicateFunc)
+}
+
 // SingleWith returns the only element of a collection that satisfies a
 // specified condition, and nil if more than one such element exists.
 func (q Query) SingleWith(predicate func(interface{}) bool) (r interface{}) {
@@ -489,6 +1313,82 @@ func (q Query) SumInts() (r int64) {
 	return
 }
 
+// SumNumeric computes the sum of the numeric values of a collection, skipping
+// the elements that are not numeric. It returns the sum along with the number
+// of skipped elements.
+//
+// Values can be of any integer, unsigned integer or float type, and they can
+// be mixed. The result is float64. Method returns zero if collection contains
+// no numeric elements.
+func (q Query) SumNumeric() (sum float64, skipped int) {
+	next := q.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		if v, numeric := toFloat64(item); numeric {
+			sum += v
+		} else {
+			skipped++
+		}
+	}
+
+	return
+}
+
+// SumTyped computes the sum of a collection of numeric values, keeping integer
+// sums exact.
+//
+// If all the values are of signed integer types, the result is an int64. If
+// they are all of unsigned integer types, the result is a uint64. Otherwise,
+// the values are summed as float64, and the result is NaN if a value is not
+// numeric. Method returns nil if collection contains no elements.
+func (q Query) SumTyped() interface{} {
+	items := q.Results()
+	if len(items) == 0 {
+		return nil
+	}
+
+	signed, unsigned := true, true
+	for _, item := range items {
+		switch item.(type) {
+		case int, int8, int16, int32, int64:
+			unsigned = false
+		case uint, uint8, uint16, uint32, uint64:
+			signed = false
+		default:
+			signed, unsigned = false, false
+		}
+	}
+
+	switch {
+	case signed:
+		var r int64
+		for _, item := range items {
+			r += getIntConverter(item)(item)
+		}
+
+		return r
+	case unsigned:
+		var r uint64
+		for _, item := range items {
+			r += getUIntConverter(item)(item)
+		}
+
+		return r
+	}
+
+	var r float64
+	for _, item := range items {
+		f, ok := toFloat64(item)
+		if !ok {
+			return math.NaN()
+		}
+
+		r += f
+	}
+
+	return r
+}
+
 // SumUInts computes the sum of a collection of numeric values.
 //
 // Values can be of any unsigned integer type: uint, uint8, uint16, uint32,
@@ -544,6 +1444,43 @@ func (q Query) ToChannel(result chan<- interface{}) {
 	close(result)
 }
 
+// ToFloat64Slice iterates over a collection and returns its elements as a
+// []float64. An error wrapping ErrTypeMismatch is returned if an element is
+// not a float64.
+func (q Query) ToFloat64Slice() ([]float64, error) {
+	next := q.Iterate()
+	r := []float64{}
+
+	for item, ok := next(); ok; item, ok = next() {
+		f, ok := item.(float64)
+		if !ok {
+			return nil, fmt.Errorf("ToFloat64Slice: %w: element %d is a %T", ErrTypeMismatch, len(r), item)
+		}
+
+		r = append(r, f)
+	}
+
+	return r, nil
+}
+
+// ToIntSlice iterates over a collection and returns its elements as a []int.
+// An error wrapping ErrTypeMismatch is returned if an element is not an int.
+func (q Query) ToIntSlice() ([]int, error) {
+	next := q.Iterate()
+	r := []int{}
+
+	for item, ok := next(); ok; item, ok = next() {
+		i, ok := item.(int)
+		if !ok {
+			return nil, fmt.Errorf("ToIntSlice: %w: element %d is a %T", ErrTypeMismatch, len(r), item)
+		}
+
+		r = append(r, i)
+	}
+
+	return r, nil
+}
+
 // ToMap iterates over a collection and populates result map with elements.
 // Collection elements have to be of KeyValue type to use this method. To
 // populate a map with elements of different type use ToMapBy method. ToMap
@@ -616,6 +1553,48 @@ func (q Query) ToMapByT(result interface{},
 	q.ToMapBy(result, keySelectorFunc, valueSelectorFunc)
 }
 
+// ToSet iterates over a collection and returns a set of its elements, which can
+// be used for fast membership tests. Duplicate elements are stored once.
+func (q Query) ToSet() map[interface{}]struct{} {
+	return q.ToSetBy(func(item interface{}) interface{} {
+		return item
+	})
+}
+
+// ToSetBy iterates over a collection and returns a set of keys. Function
+// selector is executed for each element of the collection to generate its key.
+func (q Query) ToSetBy(selector func(interface{}) interface{}) map[interface{}]struct{} {
+	next := q.Iterate()
+	set := make(map[interface{}]struct{})
+
+	for item, ok := next(); ok; item, ok = next() {
+		set[selector(item)] = struct{}{}
+	}
+
+	return set
+}
+
+// ToSetByT is the typed version of ToSetBy.
+//
+//   - selectorFn is of type "func(TSource)TKey"
+//
+// NOTE: ToSetBy has better performance than ToSetByT.
+func (q Query) ToSetByT(selectorFn interface{}) map[interface{}]struct{} {
+	selectorGenericFunc, err := newGenericFunc(
+		"ToSetByT", "selectorFn", selectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	selectorFunc := func(item interface{}) interface{} {
+		return selectorGenericFunc.Call(item)
+	}
+
+	return q.ToSetBy(selectorFunc)
+}
+
 // ToSlice iterates over a collection and saves the results in the slice pointed
 // by v. It overwrites the existing slice, starting from index 0.
 //
@@ -643,6 +1622,49 @@ func (q Query) ToSlice(v interface{}) {
 	res.Elem().Set(slice.Slice(0, index))
 }
 
+// ToStringSlice iterates over a collection and returns its elements as a
+// []string. An error wrapping ErrTypeMismatch is returned if an element is not
+// a string.
+func (q Query) ToStringSlice() ([]string, error) {
+	next := q.Iterate()
+	r := []string{}
+
+	for item, ok := next(); ok; item, ok = next() {
+		s, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("ToStringSlice: %w: element %d is a %T", ErrTypeMismatch, len(r), item)
+		}
+
+		r = append(r, s)
+	}
+
+	return r, nil
+}
+
+// extremeTime returns the element of a collection of time.Time values for
+// which better reports true against every other element.
+func (q Query) extremeTime(method string, better func(time.Time, time.Time) bool) (r time.Time, err error) {
+	next := q.Iterate()
+	found := false
+
+	for item, ok := next(); ok; item, ok = next() {
+		t, ok := item.(time.Time)
+		if !ok {
+			return time.Time{}, fmt.Errorf("%s: %w: %v is a %T", method, ErrTypeMismatch, item, item)
+		}
+
+		if !found || better(t, r) {
+			r, found = t, true
+		}
+	}
+
+	if !found {
+		return time.Time{}, fmt.Errorf("%s: %w", method, ErrEmptySequence)
+	}
+
+	return r, nil
+}
+
 // grow grows the slice s by doubling its capacity, then it returns the new
 // slice (resliced to its full capacity) and the new capacity.
 func grow(s reflect.Value) (v reflect.Value, newCap int) {
@@ -1,9 +1,12 @@
 package linq
 
 import (
+	"errors"
 	"math"
 	"reflect"
+	"sync/atomic"
 	"testing"
+	"time"
 	"unsafe"
 )
 
@@ -75,6 +78,73 @@ func TestAnyWithT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
 	})
 }
 
+func TestAtLeast(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		n     int
+		want  bool
+	}{
+		{[]int{1, 2, 2, 3, 1}, 2, true},
+		{[]int{1, 2, 2, 3, 1}, 3, false},
+		{[]int{1, 2, 2, 3, 1}, 0, true},
+		{[]int{}, -1, true},
+		{[]int{}, 1, false},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).AtLeast(test.n, func(i interface{}) bool {
+			return i.(int) == 2
+		}); r != test.want {
+			t.Errorf("From(%v).AtLeast(%v)=%v expected %v", test.input, test.n, r, test.want)
+		}
+	}
+}
+
+func TestAtLeast_StopsEarly(t *testing.T) {
+	calls := 0
+	From([]int{1, 2, 3, 4, 5}).AtLeast(2, func(i interface{}) bool {
+		calls++
+		return true
+	})
+
+	if calls != 2 {
+		t.Errorf("AtLeast(2) invoked predicate %d times, expected 2", calls)
+	}
+}
+
+func TestAtLeastT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "AtLeastT: parameter [predicateFn] has a invalid function signature. Expected: 'func(T)bool', actual: 'func(int)int'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).AtLeastT(1, func(item int) int { return item + 2 })
+	})
+}
+
+func TestAtMost(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		n     int
+		want  bool
+	}{
+		{[]int{1, 2, 2, 3, 1}, 2, true},
+		{[]int{1, 2, 2, 3, 1}, 1, false},
+		{[]int{1, 3}, 0, true},
+		{[]int{}, -1, false},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).AtMost(test.n, func(i interface{}) bool {
+			return i.(int) == 2
+		}); r != test.want {
+			t.Errorf("From(%v).AtMost(%v)=%v expected %v", test.input, test.n, r, test.want)
+		}
+	}
+}
+
+func TestAtMostT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "AtMostT: parameter [predicateFn] has a invalid function signature. Expected: 'func(T)bool', actual: 'func(int)int'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).AtMostT(1, func(item int) int { return item + 2 })
+	})
+}
+
 func TestAverage(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -133,6 +203,78 @@ func TestCount(t *testing.T) {
 	}
 }
 
+func TestCount_Channel(t *testing.T) {
+	c := make(chan interface{})
+	go func() {
+		for i := 0; i < 1000; i++ {
+			c <- i
+		}
+		close(c)
+	}()
+
+	q := FromChannel(c).Where(func(i interface{}) bool {
+		return i.(int)%10 == 0
+	})
+	if r := q.Count(); r != 100 {
+		t.Errorf("FromChannel().Where().Count()=%v expected 100", r)
+	}
+}
+
+func TestCountUntil(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  int
+	}{
+		{[]int{1, 2, 3, 1, 2}, 2},
+		{[]int{3, 1}, 0},
+		{[]int{1, 2}, 2},
+		{[]int{}, 0},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).CountUntil(func(i interface{}) bool {
+			return i.(int) >= 3
+		}); r != test.want {
+			t.Errorf("From(%v).CountUntil()=%v expected %v", test.input, r, test.want)
+		}
+	}
+}
+
+func TestCountUntilT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "CountUntilT: parameter [predicateFn] has a invalid function signature. Expected: 'func(T)bool', actual: 'func(int)int'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).CountUntilT(func(item int) int { return item + 2 })
+	})
+}
+
+func TestCountWhile(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  int
+		calls int
+	}{
+		{[]int{1, 2, 3, 1, 2}, 2, 3},
+		{[]int{3, 1}, 0, 1},
+		{[]int{1, 2}, 2, 2},
+		{[]int{}, 0, 0},
+	}
+
+	for _, test := range tests {
+		calls := 0
+		if r := From(test.input).CountWhile(func(i interface{}) bool {
+			calls++
+			return i.(int) < 3
+		}); r != test.want || calls != test.calls {
+			t.Errorf("From(%v).CountWhile()=%v after %d calls expected %v after %d calls", test.input, r, calls, test.want, test.calls)
+		}
+	}
+}
+
+func TestCountWhileT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "CountWhileT: parameter [predicateFn] has a invalid function signature. Expected: 'func(T)bool', actual: 'func(int)int'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).CountWhileT(func(item int) int { return item + 2 })
+	})
+}
+
 func TestCountWith(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -157,6 +299,34 @@ func TestCountWithT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
 	})
 }
 
+func TestExactly(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		n     int
+		want  bool
+	}{
+		{[]int{1, 2, 2, 3, 1}, 2, true},
+		{[]int{1, 2, 2, 3, 1}, 1, false},
+		{[]int{1, 2, 2, 3, 1}, 3, false},
+		{[]int{1, 3}, 0, true},
+		{[]int{}, -1, false},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).Exactly(test.n, func(i interface{}) bool {
+			return i.(int) == 2
+		}); r != test.want {
+			t.Errorf("From(%v).Exactly(%v)=%v expected %v", test.input, test.n, r, test.want)
+		}
+	}
+}
+
+func TestExactlyT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "ExactlyT: parameter [predicateFn] has a invalid function signature. Expected: 'func(T)bool', actual: 'func(int)int'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).ExactlyT(1, func(item int) int { return item + 2 })
+	})
+}
+
 func TestFirst(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -197,6 +367,32 @@ func TestFirstWithT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
 	})
 }
 
+func TestFrequencies(t *testing.T) {
+	input := []int{1, 2, 2, 3, 2}
+	want := map[interface{}]int{1: 1, 2: 3, 3: 1}
+
+	if r := From(input).Frequencies(); !reflect.DeepEqual(r, want) {
+		t.Errorf("From(%v).Frequencies()=%v expected %v", input, r, want)
+	}
+}
+
+func TestFrequenciesBy(t *testing.T) {
+	input := []string{"apple", "avocado", "banana"}
+	want := map[interface{}]int{byte('a'): 2, byte('b'): 1}
+
+	if r := From(input).FrequenciesBy(func(i interface{}) interface{} {
+		return i.(string)[0]
+	}); !reflect.DeepEqual(r, want) {
+		t.Errorf("From(%v).FrequenciesBy()=%v expected %v", input, r, want)
+	}
+}
+
+func TestFrequenciesByT_PanicWhenSelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "FrequenciesByT: parameter [selectorFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int,int)bool'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).FrequenciesByT(func(i, j int) bool { return true })
+	})
+}
+
 func TestForEach(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -224,6 +420,50 @@ func TestForEachT_PanicWhenActionFnIsInvalid(t *testing.T) {
 	})
 }
 
+func TestForEachChunk(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		size   int
+		output [][]interface{}
+	}{
+		{[]int{1, 2, 3, 4, 5}, 2, [][]interface{}{{1, 2}, {3, 4}, {5}}},
+		{[]int{1, 2, 3, 4}, 2, [][]interface{}{{1, 2}, {3, 4}}},
+		{[]int{1, 2}, 5, [][]interface{}{{1, 2}}},
+		{[]int{1, 2}, maxInt, [][]interface{}{{1, 2}}},
+		{[]int{}, 2, nil},
+		{[]int{1, 2}, 0, nil},
+	}
+
+	for _, test := range tests {
+		var chunks [][]interface{}
+		err := From(test.input).ForEachChunk(test.size, func(chunk []interface{}) error {
+			chunks = append(chunks, chunk)
+			return nil
+		})
+
+		if err != nil || !reflect.DeepEqual(chunks, test.output) {
+			t.Errorf("From(%v).ForEachChunk(%v)=%v,%v expected %v", test.input, test.size, chunks, err, test.output)
+		}
+	}
+}
+
+func TestForEachChunk_StopsAtFirstError(t *testing.T) {
+	want := errors.New("failed")
+	calls := 0
+	err := Range(1, 10).ForEachChunk(3, func(chunk []interface{}) error {
+		calls++
+		if calls == 2 {
+			return want
+		}
+
+		return nil
+	})
+
+	if err != want || calls != 2 {
+		t.Errorf("ForEachChunk()=%v after %d calls, expected %v after 2 calls", err, calls, want)
+	}
+}
+
 func TestForEachIndexed(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -251,6 +491,176 @@ func TestForEachIndexedT_PanicWhenActionFnIsInvalid(t *testing.T) {
 	})
 }
 
+func TestForEachTimeout(t *testing.T) {
+	var seen []interface{}
+	err := From([]int{1, 2, 3}).ForEachTimeout(func(i interface{}) error {
+		seen = append(seen, i)
+		return nil
+	}, time.Second)
+
+	if err != nil || !reflect.DeepEqual(seen, []interface{}{1, 2, 3}) {
+		t.Errorf("ForEachTimeout() saw %v, %v expected [1 2 3], <nil>", seen, err)
+	}
+
+	errStop := errors.New("stop")
+	if err := From([]int{1, 2, 3}).ForEachTimeout(func(i interface{}) error {
+		return errStop
+	}, time.Second); err != errStop {
+		t.Errorf("ForEachTimeout() error=%v expected %v", err, errStop)
+	}
+
+	release := make(chan struct{})
+	defer close(release)
+
+	var calls int32
+	err = From([]int{1, 2, 3}).ForEachTimeout(func(i interface{}) error {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return nil
+	}, 10*time.Millisecond)
+
+	if n := atomic.LoadInt32(&calls); !errors.Is(err, ErrTimeout) || n != 1 {
+		t.Errorf("ForEachTimeout() error=%v after %d calls expected ErrTimeout after 1 call", err, n)
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	input := []interface{}{0, 1, 2.5, 4.99, 5, 7, 10, -1, 11, math.Inf(1), "x", math.NaN()}
+
+	tests := []struct {
+		min     float64
+		max     float64
+		bins    int
+		clamp   bool
+		counts  []int
+		outside int
+	}{
+		{0, 10, 2, false, []int{4, 3}, 5},
+		{0, 10, 2, true, []int{5, 5}, 2},
+		{0, 10, 5, false, []int{2, 1, 2, 1, 1}, 5},
+		{0, 10, 0, false, nil, 12},
+		{10, 0, 2, true, nil, 12},
+	}
+
+	for _, test := range tests {
+		counts, outside := From(input).Histogram(test.min, test.max, test.bins, test.clamp)
+		if !reflect.DeepEqual(counts, test.counts) || outside != test.outside {
+			t.Errorf("Histogram(%v, %v, %d, %v)=%v, %d expected %v, %d", test.min, test.max, test.bins, test.clamp, counts, outside, test.counts, test.outside)
+		}
+	}
+
+	boundaries := []float64{0, 0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9}
+	want := []int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+	if counts, outside := From(boundaries).Histogram(0, 1, 10, false); !reflect.DeepEqual(counts, want) || outside != 0 {
+		t.Errorf("From(%v).Histogram(0, 1, 10, false)=%v, %d expected %v, 0", boundaries, counts, outside, want)
+	}
+
+	boundaries = []float64{-1.5, -1.2, -0.9, -0.6, -0.3}
+	want = []int{1, 1, 1, 1, 1}
+	if counts, outside := From(boundaries).Histogram(-1.5, 0, 5, false); !reflect.DeepEqual(counts, want) || outside != 0 {
+		t.Errorf("From(%v).Histogram(-1.5, 0, 5, false)=%v, %d expected %v, 0", boundaries, counts, outside, want)
+	}
+}
+
+func TestIndexOf(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		value interface{}
+		want  int
+	}{
+		{[]int{1, 2, 2, 3, 1}, 2, 1},
+		{[]int{1, 2, 2, 3, 1}, 1, 0},
+		{[]int{1, 2, 2, 3, 1}, 4, -1},
+		{"sstr", 't', 2},
+		{[]int{}, 1, -1},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).IndexOf(test.value); r != test.want {
+			t.Errorf("From(%v).IndexOf(%v)=%v expected %v", test.input, test.value, r, test.want)
+		}
+	}
+}
+
+func TestIndexOfWith(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  int
+	}{
+		{[]int{1, 2, 2, 3, 1}, 3},
+		{[]int{1, 2}, -1},
+		{[]int{}, -1},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).IndexOfWith(func(i interface{}) bool {
+			return i.(int) > 2
+		}); r != test.want {
+			t.Errorf("From(%v).IndexOfWith()=%v expected %v", test.input, r, test.want)
+		}
+	}
+}
+
+func TestIndexOfWithT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "IndexOfWithT: parameter [predicateFn] has a invalid function signature. Expected: 'func(T)bool', actual: 'func(int)int'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).IndexOfWithT(func(item int) int { return item + 2 })
+	})
+}
+
+func TestIsSubsetOf(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		input2 interface{}
+		want   bool
+	}{
+		{[]int{1, 2, 2}, []int{3, 2, 1}, true},
+		{[]int{1, 2, 4}, []int{1, 2, 3}, false},
+		{[]int{}, []int{1}, true},
+		{[]int{1}, []int{}, false},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).IsSubsetOf(From(test.input2)); r != test.want {
+			t.Errorf("From(%v).IsSubsetOf(%v)=%v expected %v", test.input, test.input2, r, test.want)
+		}
+
+		if r := From(test.input2).IsSupersetOf(From(test.input)); r != test.want {
+			t.Errorf("From(%v).IsSupersetOf(%v)=%v expected %v", test.input2, test.input, r, test.want)
+		}
+	}
+}
+
+func TestIsSubsetOf_StopsAtFirstMissingElement(t *testing.T) {
+	calls := 0
+	q := From([]int{1, 5, 2, 3}).Tap(func(interface{}) { calls++ })
+
+	if q.IsSubsetOf(From([]int{1, 2, 3})) || calls != 2 {
+		t.Errorf("IsSubsetOf() enumerated %d elements, expected 2", calls)
+	}
+}
+
+func TestJoinString(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		sep   string
+		want  string
+	}{
+		{[]string{"a", "b", "c"}, ", ", "a, b, c"},
+		{[]string{"a"}, ", ", "a"},
+		{[]string{}, ", ", ""},
+	}
+
+	for _, test := range tests {
+		if r, err := From(test.input).JoinString(test.sep); r != test.want || err != nil {
+			t.Errorf("From(%v).JoinString(%q)=%q, %v expected %q, <nil>", test.input, test.sep, r, err, test.want)
+		}
+	}
+
+	if _, err := From([]interface{}{"a", 1}).JoinString(","); !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("JoinString() error=%v expected ErrTypeMismatch", err)
+	}
+}
+
 func TestLast(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -267,6 +677,50 @@ func TestLast(t *testing.T) {
 	}
 }
 
+func TestLastIndexOf(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		value interface{}
+		want  int
+	}{
+		{[]int{1, 2, 2, 3, 1}, 2, 2},
+		{[]int{1, 2, 2, 3, 1}, 1, 4},
+		{[]int{1, 2, 2, 3, 1}, 4, -1},
+		{[]int{}, 1, -1},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).LastIndexOf(test.value); r != test.want {
+			t.Errorf("From(%v).LastIndexOf(%v)=%v expected %v", test.input, test.value, r, test.want)
+		}
+	}
+}
+
+func TestLastIndexOfWith(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  int
+	}{
+		{[]int{1, 2, 2, 3, 1}, 2},
+		{[]int{3, 4}, -1},
+		{[]int{}, -1},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).LastIndexOfWith(func(i interface{}) bool {
+			return i.(int) == 2
+		}); r != test.want {
+			t.Errorf("From(%v).LastIndexOfWith()=%v expected %v", test.input, r, test.want)
+		}
+	}
+}
+
+func TestLastIndexOfWithT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "LastIndexOfWithT: parameter [predicateFn] has a invalid function signature. Expected: 'func(T)bool', actual: 'func(int)int'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).LastIndexOfWithT(func(item int) int { return item + 2 })
+	})
+}
+
 func TestLastWith(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -308,6 +762,65 @@ func TestMax(t *testing.T) {
 	}
 }
 
+func TestMaxElementBy(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		elem  interface{}
+		key   interface{}
+	}{
+		{[]string{"bb", "a", "ccc", "dd", "eee"}, "ccc", 3},
+		{[]string{"a"}, "a", 1},
+		{[]string{}, nil, nil},
+	}
+
+	for _, test := range tests {
+		elem, key := From(test.input).MaxElementBy(func(i interface{}) interface{} {
+			return len(i.(string))
+		}, func(a, b interface{}) bool {
+			return a.(int) < b.(int)
+		})
+
+		if elem != test.elem || key != test.key {
+			t.Errorf("From(%v).MaxElementBy()=%v,%v expected %v,%v", test.input, elem, key, test.elem, test.key)
+		}
+	}
+}
+
+func TestMaxElementByT_PanicWhenKeySelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "MaxElementByT: parameter [keySelectorFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int,int)int'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).MaxElementByT(
+			func(i, j int) int { return i },
+			func(a, b int) bool { return a < b },
+		)
+	})
+}
+
+func TestMaxElementByT_PanicWhenLessFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "MaxElementByT: parameter [lessFn] has a invalid function signature. Expected: 'func(T,T)bool', actual: 'func(int)bool'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).MaxElementByT(
+			func(i int) int { return i },
+			func(a int) bool { return a < 0 },
+		)
+	})
+}
+
+func TestMaxTime(t *testing.T) {
+	t1 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+
+	if r, err := From([]time.Time{t1, t2, t1}).MaxTime(); !r.Equal(t2) || err != nil {
+		t.Errorf("MaxTime()=%v, %v expected %v, <nil>", r, err, t2)
+	}
+
+	if _, err := From([]time.Time{}).MaxTime(); !errors.Is(err, ErrEmptySequence) {
+		t.Errorf("MaxTime() error=%v expected ErrEmptySequence", err)
+	}
+
+	if _, err := From([]interface{}{t1, "2020"}).MaxTime(); !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("MaxTime() error=%v expected ErrTypeMismatch", err)
+	}
+}
+
 func TestMin(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -325,6 +838,124 @@ func TestMin(t *testing.T) {
 	}
 }
 
+func TestMinElementBy(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		elem  interface{}
+		key   interface{}
+	}{
+		{[]string{"bb", "a", "ccc", "dd", "eee"}, "a", 1},
+		{[]string{"a"}, "a", 1},
+		{[]string{}, nil, nil},
+	}
+
+	for _, test := range tests {
+		elem, key := From(test.input).MinElementBy(func(i interface{}) interface{} {
+			return len(i.(string))
+		}, func(a, b interface{}) bool {
+			return a.(int) < b.(int)
+		})
+
+		if elem != test.elem || key != test.key {
+			t.Errorf("From(%v).MinElementBy()=%v,%v expected %v,%v", test.input, elem, key, test.elem, test.key)
+		}
+	}
+}
+
+func TestMinElementByT_PanicWhenKeySelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "MinElementByT: parameter [keySelectorFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int,int)int'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).MinElementByT(
+			func(i, j int) int { return i },
+			func(a, b int) bool { return a < b },
+		)
+	})
+}
+
+func TestMinElementByT_PanicWhenLessFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "MinElementByT: parameter [lessFn] has a invalid function signature. Expected: 'func(T,T)bool', actual: 'func(int)bool'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).MinElementByT(
+			func(i int) int { return i },
+			func(a int) bool { return a < 0 },
+		)
+	})
+}
+
+func TestMinTime(t *testing.T) {
+	t1 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(-time.Hour)
+
+	if r, err := From([]time.Time{t1, t2, t1}).MinTime(); !r.Equal(t2) || err != nil {
+		t.Errorf("MinTime()=%v, %v expected %v, <nil>", r, err, t2)
+	}
+
+	if _, err := From([]time.Time{}).MinTime(); !errors.Is(err, ErrEmptySequence) {
+		t.Errorf("MinTime() error=%v expected ErrEmptySequence", err)
+	}
+
+	if _, err := From([]interface{}{1}).MinTime(); !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("MinTime() error=%v expected ErrTypeMismatch", err)
+	}
+}
+
+func TestMode(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  interface{}
+		count int
+	}{
+		{[]int{1, 2, 2, 3, 2}, 2, 3},
+		{[]string{"a", "b", "b", "a"}, "a", 2},
+		{"sstr", 's', 2},
+		{[]int{}, nil, 0},
+	}
+
+	for _, test := range tests {
+		if r, count := From(test.input).Mode(); r != test.want || count != test.count {
+			t.Errorf("From(%v).Mode()=%v,%v expected %v,%v", test.input, r, count, test.want, test.count)
+		}
+	}
+}
+
+func TestProduct(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  float64
+	}{
+		{[]int{1, 2, 3, 4}, 24},
+		{[]interface{}{2, uint8(3), 0.5}, 3},
+		{[]float64{0.5, 0.25}, 0.125},
+		{[]int{}, 1},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).Product(); r != test.want {
+			t.Errorf("From(%v).Product()=%v expected %v", test.input, r, test.want)
+		}
+	}
+}
+
+func TestProductForNaN(t *testing.T) {
+	if r := From([]interface{}{1, "a", 2}).Product(); !math.IsNaN(r) {
+		t.Errorf("From([1 a 2]).Product()=%v expected %v", r, math.NaN())
+	}
+}
+
+func TestProductBy(t *testing.T) {
+	input := []foo{{f1: 2}, {f1: 5}}
+
+	if r := From(input).ProductBy(func(i interface{}) interface{} {
+		return i.(foo).f1
+	}); r != 10 {
+		t.Errorf("From(%v).ProductBy()=%v expected 10", input, r)
+	}
+}
+
+func TestProductByT_PanicWhenSelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "ProductByT: parameter [selectorFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int,int)int'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).ProductByT(func(i, j int) int { return i })
+	})
+}
+
 func TestResults(t *testing.T) {
 	input := []int{1, 2, 3}
 	want := []interface{}{1, 2, 3}
@@ -334,6 +965,18 @@ func TestResults(t *testing.T) {
 	}
 }
 
+func TestResults_ReturnsNewSlice(t *testing.T) {
+	input := []interface{}{1, 2, 3}
+	q := From(input)
+
+	r := q.Results()
+	r[0] = 100
+
+	if input[0] != 1 || q.First() != 1 {
+		t.Errorf("modifying Results() changed the source to %v", input)
+	}
+}
+
 func TestSequenceEqual(t *testing.T) {
 	tests := []struct {
 		input  interface{}
@@ -352,6 +995,26 @@ func TestSequenceEqual(t *testing.T) {
 	}
 }
 
+func TestSetEqual(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		input2 interface{}
+		want   bool
+	}{
+		{[]int{1, 2, 2, 3, 1}, []int{3, 2, 1}, true},
+		{[]int{1, 2}, []int{1, 2, 3}, false},
+		{[]int{1, 2, 4}, []int{1, 2, 3}, false},
+		{[]int{}, []int{}, true},
+		{[]int{}, []int{1}, false},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).SetEqual(From(test.input2)); r != test.want {
+			t.Errorf("From(%v).SetEqual(%v)=%v expected %v", test.input, test.input2, r, test.want)
+		}
+	}
+}
+
 func TestSingle(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -369,6 +1032,38 @@ func TestSingle(t *testing.T) {
 	}
 }
 
+func TestSingleOrError(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  interface{}
+		err   error
+		calls int
+	}{
+		{[]int{1, 2, 2, 3, 1}, 3, nil, 5},
+		{[]int{1, 4, 5, 6, 7}, nil, ErrMultipleElements, 3},
+		{[]int{1, 2}, nil, ErrNoElement, 2},
+		{[]int{}, nil, ErrNoElement, 0},
+	}
+
+	for _, test := range tests {
+		calls := 0
+		r, err := From(test.input).SingleOrError(func(i interface{}) bool {
+			calls++
+			return i.(int) > 2
+		})
+
+		if r != test.want || err != test.err || calls != test.calls {
+			t.Errorf("From(%v).SingleOrError()=%v, %v after %d calls expected %v, %v after %d calls", test.input, r, err, calls, test.want, test.err, test.calls)
+		}
+	}
+}
+
+func TestSingleOrErrorT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "SingleOrErrorT: parameter [predicateFn] has a invalid function signature. Expected: 'func(T)bool', actual: 'func(int)int'", func() {
+		From([]int{1, 2, 2, 3, 1}).SingleOrErrorT(func(item int) int { return item + 2 })
+	})
+}
+
 func TestSingleWith(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -412,6 +1107,49 @@ func TestSumInts(t *testing.T) {
 	}
 }
 
+func TestSumNumeric(t *testing.T) {
+	tests := []struct {
+		input   interface{}
+		sum     float64
+		skipped int
+	}{
+		{[]interface{}{1, int8(2), uint16(3), float32(0.5), 1.5}, 8, 0},
+		{[]interface{}{1, "a", 2.5, nil, true}, 3.5, 3},
+		{[]string{"a", "b"}, 0, 2},
+		{[]int{}, 0, 0},
+	}
+
+	for _, test := range tests {
+		if sum, skipped := From(test.input).SumNumeric(); sum != test.sum || skipped != test.skipped {
+			t.Errorf("From(%v).SumNumeric()=%v,%v expected %v,%v", test.input, sum, skipped, test.sum, test.skipped)
+		}
+	}
+}
+
+func TestSumTyped(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  interface{}
+	}{
+		{[]int{1, 2, 3}, int64(6)},
+		{[]interface{}{1, int8(-2), int64(1 << 60)}, int64(1<<60 - 1)},
+		{[]interface{}{uint(1), uint8(2)}, uint64(3)},
+		{[]interface{}{1, uint8(2), 0.5}, 3.5},
+		{[]float32{0.5, 0.25}, 0.75},
+		{[]int{}, nil},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).SumTyped(); r != test.want {
+			t.Errorf("From(%v).SumTyped()=%v (%T) expected %v (%T)", test.input, r, r, test.want, test.want)
+		}
+	}
+
+	if r := From([]interface{}{1, "a"}).SumTyped().(float64); !math.IsNaN(r) {
+		t.Errorf("SumTyped() with a non-numeric value=%v expected NaN", r)
+	}
+}
+
 func TestSumUInts(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -464,6 +1202,29 @@ func TestToChannel(t *testing.T) {
 	}
 }
 
+func TestToFloat64Slice(t *testing.T) {
+	got, err := From([]float64{1.5, 2}).ToFloat64Slice()
+	if err != nil || !reflect.DeepEqual(got, []float64{1.5, 2}) {
+		t.Errorf("ToFloat64Slice()=%v, %v expected [1.5 2], <nil>", got, err)
+	}
+
+	if _, err := From([]interface{}{1.5, 2}).ToFloat64Slice(); !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("ToFloat64Slice() error=%v expected ErrTypeMismatch", err)
+	}
+}
+
+func TestToIntSlice(t *testing.T) {
+	got, err := From([]int{}).ToIntSlice()
+	if err != nil || !reflect.DeepEqual(got, []int{}) {
+		t.Errorf("ToIntSlice()=%v, %v expected [], <nil>", got, err)
+	}
+
+	got, err = From([]interface{}{1, 2, int64(3)}).ToIntSlice()
+	if !errors.Is(err, ErrTypeMismatch) || got != nil {
+		t.Errorf("ToIntSlice()=%v, %v expected <nil>, ErrTypeMismatch", got, err)
+	}
+}
+
 func TestToMap(t *testing.T) {
 	input := make(map[int]bool)
 	input[1] = true
@@ -520,6 +1281,32 @@ func TestToMapByT_PanicWhenValueSelectorFnIsInvalid(t *testing.T) {
 	})
 }
 
+func TestToSet(t *testing.T) {
+	input := []int{1, 2, 2, 3, 1}
+	want := map[interface{}]struct{}{1: {}, 2: {}, 3: {}}
+
+	if r := From(input).ToSet(); !reflect.DeepEqual(r, want) {
+		t.Errorf("From(%v).ToSet()=%v expected %v", input, r, want)
+	}
+}
+
+func TestToSetBy(t *testing.T) {
+	input := []string{"apple", "avocado", "banana"}
+	want := map[interface{}]struct{}{byte('a'): {}, byte('b'): {}}
+
+	if r := From(input).ToSetBy(func(i interface{}) interface{} {
+		return i.(string)[0]
+	}); !reflect.DeepEqual(r, want) {
+		t.Errorf("From(%v).ToSetBy()=%v expected %v", input, r, want)
+	}
+}
+
+func TestToSetByT_PanicWhenSelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "ToSetByT: parameter [selectorFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int,int)bool'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).ToSetByT(func(i, j int) bool { return true })
+	})
+}
+
 func TestToSlice(t *testing.T) {
 	tests := []struct {
 		input             []int
@@ -604,3 +1391,14 @@ func TestToSlice(t *testing.T) {
 		}
 	}
 }
+
+func TestToStringSlice(t *testing.T) {
+	got, err := From([]string{"a", "b"}).ToStringSlice()
+	if err != nil || !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("ToStringSlice()=%v, %v expected [a b], <nil>", got, err)
+	}
+
+	if _, err := From([]interface{}{"a", 'b'}).ToStringSlice(); !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("ToStringSlice() error=%v expected ErrTypeMismatch", err)
+	}
+}
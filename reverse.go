@@ -5,6 +5,10 @@ package linq
 // Unlike OrderBy, this sorting method does not consider the actual values
 // themselves in determining the order. Rather, it just returns the elements in
 // the reverse order from which they are produced by the underlying source.
+//
+// Reverse has to buffer all the elements of the source before returning the
+// first one. The buffer is private to each enumeration, so the source
+// collection itself is never modified.
 func (q Query) Reverse() Query {
 	return Query{
 		Iterate: func() Iterator {
@@ -28,3 +32,40 @@ func (q Query) Reverse() Query {
 		},
 	}
 }
+
+// Rotate cyclically shifts the elements of a collection to the left by n
+// positions, so rotating [1, 2, 3, 4] by 1 gives [2, 3, 4, 1]. A negative n
+// shifts the elements to the right. n is taken modulo the number of elements,
+// so any value is allowed.
+//
+// Like Reverse, Rotate has to buffer all the elements of the source before
+// returning the first one.
+func (q Query) Rotate(n int) Query {
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+
+			items := []interface{}{}
+			for item, ok := next(); ok; item, ok = next() {
+				items = append(items, item)
+			}
+
+			len := len(items)
+			start := 0
+			if len > 0 {
+				start = (n%len + len) % len
+			}
+
+			index := 0
+			return func() (item interface{}, ok bool) {
+				if index >= len {
+					return
+				}
+
+				item, ok = items[(start+index)%len], true
+				index++
+				return
+			}
+		},
+	}
+}
@@ -16,3 +16,33 @@ func TestReverse(t *testing.T) {
 		}
 	}
 }
+
+func TestReverse_DoesNotModifySource(t *testing.T) {
+	input := []int{1, 2, 3}
+	From(input).Reverse().Results()
+
+	if input[0] != 1 || input[1] != 2 || input[2] != 3 {
+		t.Errorf("From([1 2 3]).Reverse() modified the source: %v", input)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		n     int
+		want  []interface{}
+	}{
+		{[]int{1, 2, 3, 4}, 1, []interface{}{2, 3, 4, 1}},
+		{[]int{1, 2, 3, 4}, -1, []interface{}{4, 1, 2, 3}},
+		{[]int{1, 2, 3, 4}, 6, []interface{}{3, 4, 1, 2}},
+		{[]int{1, 2, 3, 4}, -9, []interface{}{4, 1, 2, 3}},
+		{[]int{1, 2, 3, 4}, 0, []interface{}{1, 2, 3, 4}},
+		{[]int{}, 3, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).Rotate(test.n); !validateQuery(q, test.want) {
+			t.Errorf("From(%v).Rotate(%v)=%v expected %v", test.input, test.n, toSlice(q), test.want)
+		}
+	}
+}
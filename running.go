@@ -0,0 +1,88 @@
+package linq
+
+// RunningMax returns, for each element of a collection, the largest element
+// seen so far, including the element itself. Elements are compared with the
+// specified less function. The result is a monotonically non-decreasing
+// collection with the same number of elements as the source.
+func (q Query) RunningMax(less func(a, b interface{}) bool) Query {
+	return q.running(func(best, item interface{}) bool {
+		return less(best, item)
+	})
+}
+
+// RunningMaxT is the typed version of RunningMax.
+//
+//   - lessFn is of type "func(TSource,TSource) bool"
+//
+// NOTE: RunningMax has better performance than RunningMaxT.
+func (q Query) RunningMaxT(lessFn interface{}) Query {
+	lessGenericFunc, err := newGenericFunc(
+		"RunningMaxT", "lessFn", lessFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType), new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	lessFunc := func(a, b interface{}) bool {
+		return lessGenericFunc.Call(a, b).(bool)
+	}
+
+	return q.RunningMax(lessFunc)
+}
+
+// RunningMin returns, for each element of a collection, the smallest element
+// seen so far, including the element itself. Elements are compared with the
+// specified less function. The result is a monotonically non-increasing
+// collection with the same number of elements as the source.
+func (q Query) RunningMin(less func(a, b interface{}) bool) Query {
+	return q.running(func(best, item interface{}) bool {
+		return less(item, best)
+	})
+}
+
+// RunningMinT is the typed version of RunningMin.
+//
+//   - lessFn is of type "func(TSource,TSource) bool"
+//
+// NOTE: RunningMin has better performance than RunningMinT.
+func (q Query) RunningMinT(lessFn interface{}) Query {
+	lessGenericFunc, err := newGenericFunc(
+		"RunningMinT", "lessFn", lessFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType), new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	lessFunc := func(a, b interface{}) bool {
+		return lessGenericFunc.Call(a, b).(bool)
+	}
+
+	return q.RunningMin(lessFunc)
+}
+
+// running returns, for each element of a collection, the best element seen so
+// far. Function replace reports whether item should replace the current best.
+func (q Query) running(replace func(best, item interface{}) bool) Query {
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			var best interface{}
+			started := false
+
+			return func() (item interface{}, ok bool) {
+				item, ok = next()
+				if !ok {
+					return
+				}
+
+				if !started || replace(best, item) {
+					best, started = item, true
+				}
+
+				return best, true
+			}
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package linq
+
+import "testing"
+
+func TestRunningMax(t *testing.T) {
+	less := func(a, b interface{}) bool { return a.(int) < b.(int) }
+
+	tests := []struct {
+		input  interface{}
+		output []interface{}
+	}{
+		{[]int{3, 1, 4, 1, 5, 9, 2, 6}, []interface{}{3, 3, 4, 4, 5, 9, 9, 9}},
+		{[]int{}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).RunningMax(less); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).RunningMax()=%v expected %v", test.input, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestRunningMaxT_PanicWhenLessFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "RunningMaxT: parameter [lessFn] has a invalid function signature. Expected: 'func(T,T)bool', actual: 'func(int)bool'", func() {
+		From([]int{1, 2}).RunningMaxT(func(item int) bool { return item > 1 })
+	})
+}
+
+func TestRunningMin(t *testing.T) {
+	input := []int{3, 1, 4, 1, 5, 0, 2}
+	want := []interface{}{3, 1, 1, 1, 1, 0, 0}
+
+	if q := From(input).RunningMinT(func(a, b int) bool { return a < b }); !validateQuery(q, want) {
+		t.Errorf("From(%v).RunningMinT()=%v expected %v", input, toSlice(q), want)
+	}
+}
+
+func TestRunningMinT_PanicWhenLessFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "RunningMinT: parameter [lessFn] has a invalid function signature. Expected: 'func(T,T)bool', actual: 'func(int)bool'", func() {
+		From([]int{1, 2}).RunningMinT(func(item int) bool { return item > 1 })
+	})
+}
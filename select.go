@@ -107,3 +107,108 @@ func (q Query) SelectIndexedT(selectorFn interface{}) Query {
 
 	return q.SelectIndexed(selectorFunc)
 }
+
+// Coalesce projects each element of a collection into the first non-nil result
+// of the specified selectors, which are invoked in order. Selectors after the
+// first non-nil result are not invoked. If every selector returns nil, the
+// element is projected into nil.
+//
+// Calling Coalesce without selectors returns the elements unchanged.
+func (q Query) Coalesce(selectors ...func(interface{}) interface{}) Query {
+	if len(selectors) == 0 {
+		return q
+	}
+
+	return q.Select(func(item interface{}) interface{} {
+		for _, selector := range selectors {
+			if r := selector(item); !isNil(r) {
+				return r
+			}
+		}
+
+		return nil
+	})
+}
+
+// SelectRetry projects each element of a collection into a new form using a
+// selector that can fail. When selector returns an error for an element, it is
+// invoked again for the same element, up to attempts times in total. If
+// attempts is less than 1, selector is invoked once.
+//
+// SelectRetry is not deferred: the source collection is enumerated and
+// projected when the method is called. The projection stops at the first
+// element for which every attempt failed, and the last error returned by
+// selector for that element is returned.
+func (q Query) SelectRetry(selector func(interface{}) (interface{}, error),
+	attempts int) (Query, error) {
+	next := q.Iterate()
+
+	var items []interface{}
+	for item, ok := next(); ok; item, ok = next() {
+		r, err := selector(item)
+		for attempt := 1; err != nil && attempt < attempts; attempt++ {
+			r, err = selector(item)
+		}
+
+		if err != nil {
+			return Query{}, err
+		}
+
+		items = append(items, r)
+	}
+
+	return From(items), nil
+}
+
+// SelectWhere projects each element of a collection into a new form and
+// filters the projections in a single pass. Function selector returns the
+// projection of an element and whether the projection should be kept.
+func (q Query) SelectWhere(selector func(interface{}) (interface{}, bool)) Query {
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+
+			return func() (item interface{}, ok bool) {
+				for it, hasNext := next(); hasNext; it, hasNext = next() {
+					if item, ok = selector(it); ok {
+						return
+					}
+				}
+
+				return nil, false
+			}
+		},
+	}
+}
+
+// SelectWhereT is the typed version of SelectWhere.
+//
+//   - selectorFn is of type "func(TSource)(TResult,bool)"
+//
+// NOTE: SelectWhere has better performance than SelectWhereT.
+func (q Query) SelectWhereT(selectorFn interface{}) Query {
+	selectorGenericFunc, err := newGenericFunc(
+		"SelectWhereT", "selectorFn", selectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType), new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	selectorFunc := func(item interface{}) (interface{}, bool) {
+		results := selectorGenericFunc.CallMulti(item)
+		return results[0], results[1].(bool)
+	}
+
+	return q.SelectWhere(selectorFunc)
+}
+
+// Enumerate pairs each element of a collection with its zero-based index. The
+// result is a collection of KeyValue elements, where Key is the index and Value
+// is the element, so the index can be carried through subsequent stages of a
+// query. Unzip can be used to split the pairs again.
+func (q Query) Enumerate() Query {
+	return q.SelectIndexed(func(index int, item interface{}) interface{} {
+		return KeyValue{Key: index, Value: item}
+	})
+}
@@ -1,6 +1,7 @@
 package linq
 
 import (
+	"errors"
 	"strconv"
 	"testing"
 )
@@ -58,3 +59,96 @@ func TestSelectIndexedT_PanicWhenSelectorFnIsInvalid(t *testing.T) {
 		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).SelectIndexedT(func(index string, item int) int { return item + 2 })
 	})
 }
+
+func TestCoalesce(t *testing.T) {
+	input := []map[string]interface{}{
+		{"nick": "gopher", "name": "Go"},
+		{"name": "Rust"},
+		{},
+	}
+	want := []interface{}{"gopher", "Rust", nil}
+
+	field := func(key string) func(interface{}) interface{} {
+		return func(i interface{}) interface{} {
+			return i.(map[string]interface{})[key]
+		}
+	}
+
+	if q := From(input).Coalesce(field("nick"), field("name")); !validateQuery(q, want) {
+		t.Errorf("From(%v).Coalesce()=%v expected %v", input, toSlice(q), want)
+	}
+
+	if q := From([]int{1, 2}).Coalesce(); !validateQuery(q, []interface{}{1, 2}) {
+		t.Errorf("From([1 2]).Coalesce()=%v expected [1 2]", toSlice(q))
+	}
+}
+
+func TestSelectRetry(t *testing.T) {
+	errFlaky := errors.New("flaky")
+
+	tests := []struct {
+		attempts int
+		failures int
+		output   []interface{}
+		err      error
+	}{
+		{3, 2, []interface{}{2, 4, 6}, nil},
+		{3, 3, nil, errFlaky},
+		{0, 0, []interface{}{2, 4, 6}, nil},
+		{0, 1, nil, errFlaky},
+	}
+
+	for _, test := range tests {
+		calls := make(map[interface{}]int)
+		q, err := From([]int{1, 2, 3}).SelectRetry(func(i interface{}) (interface{}, error) {
+			calls[i]++
+			if calls[i] <= test.failures {
+				return nil, errFlaky
+			}
+			return i.(int) * 2, nil
+		}, test.attempts)
+
+		if err != test.err {
+			t.Errorf("SelectRetry(%d) with %d failures error=%v expected %v", test.attempts, test.failures, err, test.err)
+		} else if err == nil && !validateQuery(q, test.output) {
+			t.Errorf("SelectRetry(%d) with %d failures=%v expected %v", test.attempts, test.failures, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestSelectWhere(t *testing.T) {
+	input := []string{"1", "x", "3", "", "5"}
+	want := []interface{}{1, 3, 5}
+
+	q := From(input).SelectWhere(func(i interface{}) (interface{}, bool) {
+		n, err := strconv.Atoi(i.(string))
+		return n, err == nil
+	})
+
+	if !validateQuery(q, want) {
+		t.Errorf("From(%v).SelectWhere()=%v expected %v", input, toSlice(q), want)
+	}
+
+	q = From(input).SelectWhereT(func(s string) (int, bool) {
+		return len(s), s != "x"
+	})
+
+	if want := []interface{}{1, 1, 0, 1}; !validateQuery(q, want) {
+		t.Errorf("From(%v).SelectWhereT()=%v expected %v", input, toSlice(q), want)
+	}
+}
+
+func TestSelectWhereT_PanicWhenSelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "SelectWhereT: parameter [selectorFn] has a invalid function signature. Expected: 'func(T)T,bool', actual: 'func(int)int'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).SelectWhereT(func(item int) int { return item + 2 })
+	})
+}
+
+func TestEnumerate(t *testing.T) {
+	input := "abc"
+	want := []interface{}{KeyValue{0, 'a'}, KeyValue{1, 'b'}, KeyValue{2, 'c'}}
+
+	if q := From(input).Enumerate(); !validateQuery(q, want) {
+		t.Errorf("From(%v).Enumerate()=%v expected %v", input, toSlice(q), want)
+	}
+}
@@ -22,6 +22,63 @@ func (q Query) Skip(count int) Query {
 	}
 }
 
+// SkipFraction bypasses the specified fraction of the elements from the start
+// of a collection and then returns the remaining elements. The number of
+// skipped elements is int(fraction*n), where n is the number of elements in the
+// collection; fractions outside of [0, 1] are clamped to that range.
+//
+// SkipFraction has to enumerate the whole collection to know its length before
+// returning the first element.
+func (q Query) SkipFraction(fraction float64) Query {
+	return Query{
+		Iterate: func() Iterator {
+			items := q.Results()
+			len := len(items)
+			index := fractionCount(fraction, len)
+
+			return func() (item interface{}, ok bool) {
+				ok = index < len
+				if ok {
+					item = items[index]
+					index++
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// SkipUntil bypasses elements in a collection until a specified condition
+// becomes true and then returns the remaining elements, starting with the
+// element that satisfied it.
+func (q Query) SkipUntil(predicate func(interface{}) bool) Query {
+	return q.SkipWhile(func(item interface{}) bool {
+		return !predicate(item)
+	})
+}
+
+// SkipUntilT is the typed version of SkipUntil.
+//
+//   - predicateFn is of type "func(TSource)bool"
+//
+// NOTE: SkipUntil has better performance than SkipUntilT.
+func (q Query) SkipUntilT(predicateFn interface{}) Query {
+	predicateGenericFunc, err := newGenericFunc(
+		"SkipUntilT", "predicateFn", predicateFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	predicateFunc := func(item interface{}) bool {
+		return predicateGenericFunc.Call(item).(bool)
+	}
+
+	return q.SkipUntil(predicateFunc)
+}
+
 // SkipWhile bypasses elements in a collection as long as a specified condition
 // is true and then returns the remaining elements.
 //
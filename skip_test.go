@@ -20,6 +20,57 @@ func TestSkip(t *testing.T) {
 	}
 }
 
+func TestSkipFraction(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		fraction float64
+		output   []interface{}
+	}{
+		{[]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 0.7, []interface{}{8, 9, 10}},
+		{[]int{1, 2, 3}, 0.5, []interface{}{2, 3}},
+		{[]int{1, 2, 3}, 1, []interface{}{}},
+		{[]int{1, 2, 3}, 2, []interface{}{}},
+		{[]int{1, 2, 3}, 0, []interface{}{1, 2, 3}},
+		{[]int{1, 2, 3}, -0.5, []interface{}{1, 2, 3}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).SkipFraction(test.fraction); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).SkipFraction(%v)=%v expected %v", test.input, test.fraction, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestSkipUntil(t *testing.T) {
+	tests := []struct {
+		input     interface{}
+		predicate func(interface{}) bool
+		output    []interface{}
+	}{
+		{[]int{1, 2}, func(i interface{}) bool {
+			return i.(int) >= 3
+		}, []interface{}{}},
+		{[9]int{1, 1, 1, 2, 1, 2, 3, 4, 2}, func(i interface{}) bool {
+			return i.(int) >= 3
+		}, []interface{}{3, 4, 2}},
+		{"sstr", func(i interface{}) bool {
+			return i.(rune) == 't'
+		}, []interface{}{'t', 'r'}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).SkipUntil(test.predicate); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).SkipUntil()=%v expected %v", test.input, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestSkipUntilT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "SkipUntilT: parameter [predicateFn] has a invalid function signature. Expected: 'func(T)bool', actual: 'func(int)int'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).SkipUntilT(func(item int) int { return item + 2 })
+	})
+}
+
 func TestSkipWhile(t *testing.T) {
 	tests := []struct {
 		input     interface{}
@@ -47,6 +98,22 @@ func TestSkipWhile(t *testing.T) {
 	}
 }
 
+func TestSkipWhile_StopsAtFirstFalse(t *testing.T) {
+	calls := 0
+	q := From([]int{1, 2, 3, 4, 5}).SkipWhile(func(i interface{}) bool {
+		calls++
+		return i.(int) < 3
+	})
+
+	if !validateQuery(q, []interface{}{3, 4, 5}) {
+		t.Errorf("SkipWhile()=%v expected [3 4 5]", toSlice(q))
+	}
+
+	if calls != 3 {
+		t.Errorf("SkipWhile() evaluated predicate %d times expected 3", calls)
+	}
+}
+
 func TestSkipWhileT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
 	mustPanicWithError(t, "SkipWhileT: parameter [predicateFn] has a invalid function signature. Expected: 'func(T)bool', actual: 'func(int,int)bool'", func() {
 		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).SkipWhileT(func(item int, x int) bool { return item == 1 })
@@ -1,5 +1,7 @@
 package linq
 
+import "math"
+
 // Take returns a specified number of contiguous elements from the start of a
 // collection.
 func (q Query) Take(count int) Query {
@@ -20,6 +22,63 @@ func (q Query) Take(count int) Query {
 	}
 }
 
+// TakeFraction returns the specified fraction of the elements from the start
+// of a collection. The number of returned elements is int(fraction*n), where n
+// is the number of elements in the collection; fractions outside of [0, 1] are
+// clamped to that range.
+//
+// TakeFraction has to enumerate the whole collection to know its length before
+// returning the first element.
+func (q Query) TakeFraction(fraction float64) Query {
+	return Query{
+		Iterate: func() Iterator {
+			items := q.Results()
+			len := fractionCount(fraction, len(items))
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				ok = index < len
+				if ok {
+					item = items[index]
+					index++
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// TakeUntil returns elements from a collection until a specified condition
+// becomes true, and then skips the element that satisfied it and all the
+// remaining elements.
+func (q Query) TakeUntil(predicate func(interface{}) bool) Query {
+	return q.TakeWhile(func(item interface{}) bool {
+		return !predicate(item)
+	})
+}
+
+// TakeUntilT is the typed version of TakeUntil.
+//
+//   - predicateFn is of type "func(TSource)bool"
+//
+// NOTE: TakeUntil has better performance than TakeUntilT.
+func (q Query) TakeUntilT(predicateFn interface{}) Query {
+	predicateGenericFunc, err := newGenericFunc(
+		"TakeUntilT", "predicateFn", predicateFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	predicateFunc := func(item interface{}) bool {
+		return predicateGenericFunc.Call(item).(bool)
+	}
+
+	return q.TakeUntil(predicateFunc)
+}
+
 // TakeWhile returns elements from a collection as long as a specified condition
 // is true, and then skips the remaining elements.
 func (q Query) TakeWhile(predicate func(interface{}) bool) Query {
@@ -127,3 +186,16 @@ func (q Query) TakeWhileIndexedT(predicateFn interface{}) Query {
 
 	return q.TakeWhileIndexed(predicateFunc)
 }
+
+// fractionCount returns the number of elements that make up the specified
+// fraction of a collection of n elements.
+func fractionCount(fraction float64, n int) int {
+	switch {
+	case fraction <= 0 || math.IsNaN(fraction):
+		return 0
+	case fraction >= 1:
+		return n
+	}
+
+	return int(fraction * float64(n))
+}
@@ -19,6 +19,58 @@ func TestTake(t *testing.T) {
 	}
 }
 
+func TestTakeFraction(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		fraction float64
+		output   []interface{}
+	}{
+		{[]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 0.3, []interface{}{1, 2, 3}},
+		{[]int{1, 2, 3}, 0.5, []interface{}{1}},
+		{[]int{1, 2, 3}, 1, []interface{}{1, 2, 3}},
+		{[]int{1, 2, 3}, 2, []interface{}{1, 2, 3}},
+		{[]int{1, 2, 3}, 0, []interface{}{}},
+		{[]int{1, 2, 3}, -0.5, []interface{}{}},
+		{[]int{}, 0.5, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).TakeFraction(test.fraction); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).TakeFraction(%v)=%v expected %v", test.input, test.fraction, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestTakeUntil(t *testing.T) {
+	tests := []struct {
+		input     interface{}
+		predicate func(interface{}) bool
+		output    []interface{}
+	}{
+		{[]int{1, 1, 1, 2, 1, 2}, func(i interface{}) bool {
+			return i.(int) >= 3
+		}, []interface{}{1, 1, 1, 2, 1, 2}},
+		{[9]int{1, 1, 1, 2, 1, 2, 3, 4, 2}, func(i interface{}) bool {
+			return i.(int) >= 3
+		}, []interface{}{1, 1, 1, 2, 1, 2}},
+		{"sstr", func(i interface{}) bool {
+			return i.(rune) == 's'
+		}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).TakeUntil(test.predicate); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).TakeUntil()=%v expected %v", test.input, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestTakeUntilT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "TakeUntilT: parameter [predicateFn] has a invalid function signature. Expected: 'func(T)bool', actual: 'func(int)int'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).TakeUntilT(func(item int) int { return item + 2 })
+	})
+}
+
 func TestTakeWhile(t *testing.T) {
 	tests := []struct {
 		input     interface{}
@@ -43,6 +95,21 @@ func TestTakeWhile(t *testing.T) {
 	}
 }
 
+func TestTakeWhile_StopsAtFirstFalse(t *testing.T) {
+	calls := 0
+	q := From([]int{1, 2, 3, 4, 5}).TakeWhile(func(i interface{}) bool {
+		calls++
+		return i.(int) < 3
+	})
+
+	q.Results()
+	q.Results()
+
+	if calls != 6 {
+		t.Errorf("TakeWhile() evaluated predicate %d times expected 6", calls)
+	}
+}
+
 func TestTakeWhileT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
 	mustPanicWithError(t, "TakeWhileT: parameter [predicateFn] has a invalid function signature. Expected: 'func(T)bool', actual: 'func(int)int'", func() {
 		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).TakeWhileT(func(item int) int { return item + 2 })
@@ -0,0 +1,46 @@
+package linq
+
+// Tap performs the specified action on each element of a collection as it is
+// enumerated, and returns the element unchanged. It is mostly useful for
+// inspecting, e.g. logging, the elements flowing through a stage of a query
+// without breaking the chain.
+//
+// Since queries are lazy, action is only invoked when the resulting query is
+// enumerated, and it is invoked again on every enumeration.
+func (q Query) Tap(action func(interface{})) Query {
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+
+			return func() (item interface{}, ok bool) {
+				item, ok = next()
+				if ok {
+					action(item)
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// TapT is the typed version of Tap.
+//
+//   - actionFn is of type "func(TSource)"
+//
+// NOTE: Tap has better performance than TapT.
+func (q Query) TapT(actionFn interface{}) Query {
+	actionGenericFunc, err := newGenericFunc(
+		"TapT", "actionFn", actionFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), nil),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	actionFunc := func(item interface{}) {
+		actionGenericFunc.Call(item)
+	}
+
+	return q.Tap(actionFunc)
+}
@@ -0,0 +1,33 @@
+package linq
+
+import "testing"
+
+func TestTap(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+	want := []interface{}{2, 4}
+
+	var seen []interface{}
+	q := From(input).Tap(func(i interface{}) {
+		seen = append(seen, i)
+	}).Where(func(i interface{}) bool {
+		return i.(int)%2 == 0
+	})
+
+	if len(seen) != 0 {
+		t.Errorf("Tap() invoked action before enumeration: %v", seen)
+	}
+
+	if !validateQuery(q, want) {
+		t.Errorf("From(%v).Tap().Where()=%v expected %v", input, toSlice(q), want)
+	}
+
+	if len(seen) != len(input) {
+		t.Errorf("Tap() saw %v expected %v", seen, input)
+	}
+}
+
+func TestTapT_PanicWhenActionFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "TapT: parameter [actionFn] has a invalid function signature. Expected: 'func(T)', actual: 'func(int,int)'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).TapT(func(i, j int) {})
+	})
+}
@@ -0,0 +1,66 @@
+package linq
+
+import "sync"
+
+// Tee returns two queries that share a single enumeration of the source
+// collection. Elements are pulled from the source at most once, the first time
+// either query needs them, and are buffered so that both queries observe the
+// same elements in the same order. This allows running two different
+// downstream chains over a source that cannot be enumerated twice, such as a
+// channel.
+//
+// Elements are kept in memory for as long as the returned queries are
+// reachable.
+func (q Query) Tee() (Query, Query) {
+	branch := q.Cache()
+	return branch, branch
+}
+
+// Cache returns a query which enumerates the source collection at most once.
+// Elements are buffered the first time they are produced, and subsequent
+// enumerations of the returned query, including concurrent ones, replay the
+// buffered elements instead of running the upstream operators again.
+//
+// Cache is useful when a query is expensive to compute and is enumerated
+// several times, or when its source can only be enumerated once.
+func (q Query) Cache() Query {
+	var (
+		mutex  sync.Mutex
+		next   Iterator
+		buffer []interface{}
+		done   bool
+	)
+
+	return Query{
+		Iterate: func() Iterator {
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				if index >= len(buffer) {
+					if done {
+						return
+					}
+
+					if next == nil {
+						next = q.Iterate()
+					}
+
+					item, ok = next()
+					if !ok {
+						done = true
+						return
+					}
+
+					buffer = append(buffer, item)
+				}
+
+				item, ok = buffer[index], true
+				index++
+				return
+			}
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package linq
+
+import "testing"
+
+func TestTee(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+	want := []interface{}{1, 2, 3, 4}
+
+	calls := 0
+	q1, q2 := From(input).Select(func(i interface{}) interface{} {
+		calls++
+		return i
+	}).Tee()
+
+	if !validateQuery(q1, want) {
+		t.Errorf("From(%v).Tee()[0]=%v expected %v", input, toSlice(q1), want)
+	}
+
+	if !validateQuery(q2, want) {
+		t.Errorf("From(%v).Tee()[1]=%v expected %v", input, toSlice(q2), want)
+	}
+
+	if calls != len(input) {
+		t.Errorf("Tee() enumerated the source %d times, expected once", calls/len(input))
+	}
+}
+
+func TestTee_Channel(t *testing.T) {
+	c := make(chan interface{}, 3)
+	c <- 1
+	c <- 2
+	c <- 3
+	close(c)
+
+	q1, q2 := FromChannel(c).Tee()
+	next1, next2 := q1.Iterate(), q2.Iterate()
+
+	for _, want := range []interface{}{1, 2, 3} {
+		item1, _ := next1()
+		item2, _ := next2()
+		if item1 != want || item2 != want {
+			t.Errorf("FromChannel().Tee()=%v,%v expected %v", item1, item2, want)
+		}
+	}
+
+	if q1.Count() != 3 || q2.Count() != 3 {
+		t.Errorf("FromChannel().Tee().Count()=%v,%v expected 3", q1.Count(), q2.Count())
+	}
+}
+
+func TestCache(t *testing.T) {
+	input := []int{1, 2, 3}
+	want := []interface{}{2, 4, 6}
+
+	calls := 0
+	q := From(input).Select(func(i interface{}) interface{} {
+		calls++
+		return i.(int) * 2
+	}).Cache()
+
+	for i := 0; i < 3; i++ {
+		if !validateQuery(q, want) {
+			t.Errorf("From(%v).Cache()=%v expected %v", input, toSlice(q), want)
+		}
+	}
+
+	if calls != len(input) {
+		t.Errorf("Cache() invoked the selector %d times, expected %d", calls, len(input))
+	}
+}
+
+func TestCache_PartialEnumeration(t *testing.T) {
+	q := Range(1, 5).Cache()
+
+	if r := q.Take(2).Results(); len(r) != 2 {
+		t.Errorf("Range(1, 5).Cache().Take(2)=%v expected [1 2]", r)
+	}
+
+	if want := []interface{}{1, 2, 3, 4, 5}; !validateQuery(q, want) {
+		t.Errorf("Range(1, 5).Cache()=%v expected %v", toSlice(q), want)
+	}
+}
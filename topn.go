@@ -0,0 +1,140 @@
+package linq
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// TopN returns the n largest elements of a collection, from the largest to the
+// smallest. Elements are compared with the specified less function. If n is
+// greater than the number of elements, all the elements are returned, sorted.
+// If n is not positive, the result is an empty collection.
+//
+// TopN keeps at most n elements in memory and runs in O(m log n) time for a
+// collection of m elements, which is much cheaper than sorting the whole
+// collection when n is small.
+func (q Query) TopN(n int, less func(a, b interface{}) bool) Query {
+	return q.topN(n, less)
+}
+
+// TopNT is the typed version of TopN.
+//
+//   - lessFn is of type "func(TSource,TSource) bool"
+//
+// NOTE: TopN has better performance than TopNT.
+func (q Query) TopNT(n int, lessFn interface{}) Query {
+	lessGenericFunc, err := newGenericFunc(
+		"TopNT", "lessFn", lessFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType), new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	lessFunc := func(a, b interface{}) bool {
+		return lessGenericFunc.Call(a, b).(bool)
+	}
+
+	return q.TopN(n, lessFunc)
+}
+
+// BottomN returns the n smallest elements of a collection, from the smallest
+// to the largest. Elements are compared with the specified less function. If n
+// is greater than the number of elements, all the elements are returned,
+// sorted. If n is not positive, the result is an empty collection.
+//
+// Like TopN, BottomN keeps at most n elements in memory.
+func (q Query) BottomN(n int, less func(a, b interface{}) bool) Query {
+	return q.topN(n, func(a, b interface{}) bool {
+		return less(b, a)
+	})
+}
+
+// BottomNT is the typed version of BottomN.
+//
+//   - lessFn is of type "func(TSource,TSource) bool"
+//
+// NOTE: BottomN has better performance than BottomNT.
+func (q Query) BottomNT(n int, lessFn interface{}) Query {
+	lessGenericFunc, err := newGenericFunc(
+		"BottomNT", "lessFn", lessFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType), new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	lessFunc := func(a, b interface{}) bool {
+		return lessGenericFunc.Call(a, b).(bool)
+	}
+
+	return q.BottomN(n, lessFunc)
+}
+
+// boundedHeap is a min-heap of items, ordered by less.
+type boundedHeap struct {
+	items []interface{}
+	less  func(a, b interface{}) bool
+}
+
+func (h *boundedHeap) Len() int {
+	return len(h.items)
+}
+
+func (h *boundedHeap) Less(i, j int) bool {
+	return h.less(h.items[i], h.items[j])
+}
+
+func (h *boundedHeap) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+func (h *boundedHeap) Push(x interface{}) {
+	h.items = append(h.items, x)
+}
+
+func (h *boundedHeap) Pop() interface{} {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
+
+// topN returns the n largest elements of a collection according to less, from
+// the largest to the smallest.
+func (q Query) topN(n int, less func(a, b interface{}) bool) Query {
+	return Query{
+		Iterate: func() Iterator {
+			h := &boundedHeap{less: less}
+
+			if n > 0 {
+				next := q.Iterate()
+				for item, ok := next(); ok; item, ok = next() {
+					if h.Len() < n {
+						heap.Push(h, item)
+					} else if less(h.items[0], item) {
+						h.items[0] = item
+						heap.Fix(h, 0)
+					}
+				}
+			}
+
+			items := h.items
+			sort.SliceStable(items, func(i, j int) bool {
+				return less(items[j], items[i])
+			})
+
+			len := len(items)
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				ok = index < len
+				if ok {
+					item = items[index]
+					index++
+				}
+
+				return
+			}
+		},
+	}
+}
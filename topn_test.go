@@ -0,0 +1,46 @@
+package linq
+
+import "testing"
+
+func TestTopN(t *testing.T) {
+	less := func(a, b interface{}) bool { return a.(int) < b.(int) }
+
+	tests := []struct {
+		input  interface{}
+		n      int
+		output []interface{}
+	}{
+		{[]int{3, 1, 4, 1, 5, 9, 2, 6}, 3, []interface{}{9, 6, 5}},
+		{[]int{3, 1, 4}, 10, []interface{}{4, 3, 1}},
+		{[]int{3, 1, 4}, 0, []interface{}{}},
+		{[]int{3, 1, 4}, -1, []interface{}{}},
+		{[]int{}, 2, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).TopN(test.n, less); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).TopN(%d)=%v expected %v", test.input, test.n, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestTopNT_PanicWhenLessFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "TopNT: parameter [lessFn] has a invalid function signature. Expected: 'func(T,T)bool', actual: 'func(int)bool'", func() {
+		From([]int{1, 2}).TopNT(1, func(item int) bool { return item > 1 })
+	})
+}
+
+func TestBottomN(t *testing.T) {
+	input := []int{3, 1, 4, 1, 5, 9, 2, 6}
+	want := []interface{}{1, 1, 2, 3}
+
+	if q := From(input).BottomNT(4, func(a, b int) bool { return a < b }); !validateQuery(q, want) {
+		t.Errorf("From(%v).BottomNT(4)=%v expected %v", input, toSlice(q), want)
+	}
+}
+
+func TestBottomNT_PanicWhenLessFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "BottomNT: parameter [lessFn] has a invalid function signature. Expected: 'func(T,T)bool', actual: 'func(int)bool'", func() {
+		From([]int{1, 2}).BottomNT(1, func(item int) bool { return item > 1 })
+	})
+}
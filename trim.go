@@ -0,0 +1,60 @@
+package linq
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Trim sorts a collection of numeric values in ascending order and removes the
+// specified fractions of its lowest and highest values, returning the values
+// in between. The numbers of removed values are int(lower*n) and int(upper*n),
+// where n is the number of elements in the collection; fractions outside of
+// [0, 1] are clamped to that range. If the fractions add up to 1 or more, the
+// result is an empty collection.
+//
+// Trim is typically used to remove outliers before computing an average.
+//
+// Trim panics with an error wrapping ErrTypeMismatch when the collection
+// contains an element that is not numeric.
+func (q Query) Trim(lower, upper float64) Query {
+	return Query{
+		Iterate: func() Iterator {
+			var pairs []struct {
+				key  float64
+				item interface{}
+			}
+
+			next := q.Iterate()
+			for item, ok := next(); ok; item, ok = next() {
+				key, ok := toFloat64(item)
+				if !ok {
+					panic(fmt.Errorf("Trim: %w: %v is a %T", ErrTypeMismatch, item, item))
+				}
+
+				pairs = append(pairs, struct {
+					key  float64
+					item interface{}
+				}{key, item})
+			}
+
+			sort.SliceStable(pairs, func(i, j int) bool {
+				a, b := pairs[i].key, pairs[j].key
+				return a < b || (!math.IsNaN(a) && math.IsNaN(b))
+			})
+
+			index := fractionCount(lower, len(pairs))
+			end := len(pairs) - fractionCount(upper, len(pairs))
+
+			return func() (item interface{}, ok bool) {
+				ok = index < end
+				if ok {
+					item = pairs[index].item
+					index++
+				}
+
+				return
+			}
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package linq
+
+import "testing"
+
+func TestTrim(t *testing.T) {
+	input := []int{50, 3, 1, 4, 2, 5, 9, 6, 8, 7}
+
+	tests := []struct {
+		lower  float64
+		upper  float64
+		output []interface{}
+	}{
+		{0.1, 0.1, []interface{}{2, 3, 4, 5, 6, 7, 8, 9}},
+		{0.25, 0, []interface{}{3, 4, 5, 6, 7, 8, 9, 50}},
+		{0, 0.5, []interface{}{1, 2, 3, 4, 5}},
+		{-1, 0.35, []interface{}{1, 2, 3, 4, 5, 6, 7}},
+		{0.5, 0.5, []interface{}{}},
+		{0.9, 0.9, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(input).Trim(test.lower, test.upper); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).Trim(%v, %v)=%v expected %v", input, test.lower, test.upper, toSlice(q), test.output)
+		}
+	}
+
+	mixed := []interface{}{2.5, 1, uint8(3), float32(4)}
+	if q := From(mixed).Trim(0, 0.25); !validateQuery(q, []interface{}{1, 2.5, uint8(3)}) {
+		t.Errorf("From(%v).Trim(0, 0.25)=%v expected [1 2.5 3]", mixed, toSlice(q))
+	}
+}
+
+func TestTrim_PanicWhenElementIsNotNumeric(t *testing.T) {
+	mustPanicWithError(t, "Trim: element type mismatch: x is a string", func() {
+		From([]interface{}{1, "x", 2.5}).Trim(0, 0.5).Results()
+	})
+}
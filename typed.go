@@ -0,0 +1,104 @@
+//go:build go1.18
+// +build go1.18
+
+package linq
+
+// TypedIterator is the type parameterized counterpart of Iterator.
+type TypedIterator[T any] func() (item T, ok bool)
+
+// TypedQuery is the type parameterized counterpart of Query. It is type safe at
+// compile time and does not box elements into interface{}, so it avoids both
+// type assertions and the allocations they imply.
+//
+// Methods can't have type parameters in Go, so operations which change the
+// element type, such as Select, are package level functions.
+type TypedQuery[T any] struct {
+	Iterate func() TypedIterator[T]
+}
+
+// FromT initializes a typed linq query with passed slice as the source.
+func FromT[T any](source []T) TypedQuery[T] {
+	len := len(source)
+
+	return TypedQuery[T]{
+		Iterate: func() TypedIterator[T] {
+			index := 0
+
+			return func() (item T, ok bool) {
+				ok = index < len
+				if ok {
+					item = source[index]
+					index++
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// Where filters a collection of values based on a predicate.
+func (q TypedQuery[T]) Where(predicate func(T) bool) TypedQuery[T] {
+	return TypedQuery[T]{
+		Iterate: func() TypedIterator[T] {
+			next := q.Iterate()
+
+			return func() (item T, ok bool) {
+				for item, ok = next(); ok; item, ok = next() {
+					if predicate(item) {
+						return
+					}
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// Select projects each element of a collection into a new form. Returns a query
+// with the result of invoking the transform function on each element of
+// original source.
+func Select[T, U any](q TypedQuery[T], selector func(T) U) TypedQuery[U] {
+	return TypedQuery[U]{
+		Iterate: func() TypedIterator[U] {
+			next := q.Iterate()
+
+			return func() (item U, ok bool) {
+				var it T
+				it, ok = next()
+				if ok {
+					item = selector(it)
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// ToSlice iterates over a collection and returns a slice of its elements.
+func (q TypedQuery[T]) ToSlice() (r []T) {
+	next := q.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		r = append(r, item)
+	}
+
+	return
+}
+
+// AsQuery converts a typed query into a Query, so that the rest of the linq
+// methods can be applied to it.
+func (q TypedQuery[T]) AsQuery() Query {
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+
+			return func() (item interface{}, ok bool) {
+				item, ok = next()
+				return
+			}
+		},
+	}
+}
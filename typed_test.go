@@ -0,0 +1,69 @@
+//go:build go1.18
+// +build go1.18
+
+package linq
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestFromT(t *testing.T) {
+	input := []int{1, 2, 3}
+
+	if r := FromT(input).ToSlice(); !reflect.DeepEqual(r, input) {
+		t.Errorf("FromT(%v).ToSlice()=%v expected %v", input, r, input)
+	}
+}
+
+func TestTypedQuery_Where(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6}
+	want := []int{2, 4, 6}
+
+	r := FromT(input).Where(func(i int) bool { return i%2 == 0 }).ToSlice()
+	if !reflect.DeepEqual(r, want) {
+		t.Errorf("FromT(%v).Where()=%v expected %v", input, r, want)
+	}
+}
+
+func TestTypedQuery_Select(t *testing.T) {
+	input := []int{1, 2, 3}
+	want := []string{"a", "aa", "aaa"}
+
+	r := Select(FromT(input), func(i int) string {
+		s := ""
+		for ; i > 0; i-- {
+			s += "a"
+		}
+		return s
+	}).ToSlice()
+	if !reflect.DeepEqual(r, want) {
+		t.Errorf("Select(FromT(%v))=%v expected %v", input, r, want)
+	}
+}
+
+func TestTypedQuery_AsQuery(t *testing.T) {
+	input := []int{1, 2, 3}
+	want := []interface{}{3, 2, 1}
+
+	if q := FromT(input).AsQuery().Reverse(); !validateQuery(q, want) {
+		t.Errorf("FromT(%v).AsQuery().Reverse()=%v expected %v", input, toSlice(q), want)
+	}
+}
+
+// The following code example demonstrates how to use the type
+// parameterized FromT, Where and Select to query a slice without
+// type assertions.
+func ExampleFromT() {
+	numbers := []int{1, 2, 3, 4, 5, 6}
+
+	squares := Select(
+		FromT(numbers).Where(func(n int) bool { return n%2 == 0 }),
+		func(n int) int { return n * n },
+	).ToSlice()
+
+	fmt.Println(squares)
+	// Output:
+	// [4 16 36]
+}
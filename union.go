@@ -38,3 +38,57 @@ func (q Query) Union(q2 Query) Query {
 		},
 	}
 }
+
+// SymmetricDifference produces the set symmetric difference of two
+// collections, that is the elements that appear in exactly one of them.
+//
+// This method excludes duplicates from the return set. The elements of the
+// first collection are returned first, followed by the elements of the second
+// collection, each in the order of their first appearance.
+func (q Query) SymmetricDifference(q2 Query) Query {
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			next2 := q2.Iterate()
+
+			var items []interface{}
+			set := make(map[interface{}]bool)
+			for i, ok := next(); ok; i, ok = next() {
+				if _, has := set[i]; !has {
+					set[i] = true
+					items = append(items, i)
+				}
+			}
+
+			set2 := make(map[interface{}]bool)
+			var items2 []interface{}
+			for i, ok := next2(); ok; i, ok = next2() {
+				if _, has := set2[i]; !has {
+					set2[i] = true
+					if _, has := set[i]; !has {
+						items2 = append(items2, i)
+					}
+				}
+			}
+
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				for ; index < len(items); index++ {
+					if _, has := set2[items[index]]; !has {
+						item, ok = items[index], true
+						index++
+						return
+					}
+				}
+
+				if index-len(items) < len(items2) {
+					item, ok = items2[index-len(items)], true
+					index++
+				}
+
+				return
+			}
+		},
+	}
+}
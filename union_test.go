@@ -11,3 +11,22 @@ func TestUnion(t *testing.T) {
 		t.Errorf("From(%v).Union(%v)=%v expected %v", input1, input2, toSlice(q), want)
 	}
 }
+
+func TestSymmetricDifference(t *testing.T) {
+	tests := []struct {
+		input1 interface{}
+		input2 interface{}
+		output []interface{}
+	}{
+		{[]int{1, 2, 3, 2}, []int{5, 2, 4, 5, 1}, []interface{}{3, 5, 4}},
+		{[]int{1, 2}, []int{1, 2}, []interface{}{}},
+		{[]int{}, []int{1, 1}, []interface{}{1}},
+		{[]int{2, 1, 2}, []int{}, []interface{}{2, 1}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input1).SymmetricDifference(From(test.input2)); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).SymmetricDifference(%v)=%v expected %v", test.input1, test.input2, toSlice(q), test.output)
+		}
+	}
+}
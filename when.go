@@ -0,0 +1,18 @@
+package linq
+
+// When applies transform to the query if cond is true, and returns the query
+// unchanged otherwise. It allows to build a query conditionally without
+// breaking the method chain, for example to apply optional filters:
+//
+//	From(products).
+//		When(onlyInStock, func(q Query) Query {
+//			return q.Where(func(p interface{}) bool { return p.(Product).Stock > 0 })
+//		}).
+//		OrderBy(...)
+func (q Query) When(cond bool, transform func(Query) Query) Query {
+	if !cond {
+		return q
+	}
+
+	return transform(q)
+}
@@ -0,0 +1,22 @@
+package linq
+
+import "testing"
+
+func TestWhen(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+	even := func(q Query) Query {
+		return q.Where(func(i interface{}) bool { return i.(int)%2 == 0 })
+	}
+
+	if q := From(input).When(true, even); !validateQuery(q, []interface{}{2, 4}) {
+		t.Errorf("From(%v).When(true)=%v expected [2 4]", input, toSlice(q))
+	}
+
+	called := false
+	if q := From(input).When(false, func(q Query) Query {
+		called = true
+		return q
+	}); called || !validateQuery(q, []interface{}{1, 2, 3, 4}) {
+		t.Errorf("From(%v).When(false)=%v expected [1 2 3 4] without calling transform", input, toSlice(q))
+	}
+}
@@ -1,5 +1,10 @@
 package linq
 
+import (
+	"reflect"
+	"sync"
+)
+
 // Where filters a collection of values based on a predicate.
 func (q Query) Where(predicate func(interface{}) bool) Query {
 	return Query{
@@ -87,3 +92,292 @@ func (q Query) WhereIndexedT(predicateFn interface{}) Query {
 
 	return q.WhereIndexed(predicateFunc)
 }
+
+// WhereNotNil filters out nil elements from a collection. Both untyped nil
+// values and typed nil values, such as a nil pointer stored in an interface{},
+// are removed.
+func (q Query) WhereNotNil() Query {
+	return q.Where(func(item interface{}) bool {
+		return !isNil(item)
+	})
+}
+
+// Compact filters out the elements of a collection that are nil or equal to
+// the zero value of their type, such as 0, "" and false. The order of the
+// remaining elements is preserved.
+func (q Query) Compact() Query {
+	return q.Where(func(item interface{}) bool {
+		return item != nil && !reflect.ValueOf(item).IsZero()
+	})
+}
+
+// EveryNth returns every n-th element of a collection, that is the elements at
+// the one-based positions n, 2n, 3n and so on, and discards the rest. It is
+// useful to downsample a dense sequence. If n is not positive, the result is
+// an empty collection.
+func (q Query) EveryNth(n int) Query {
+	return q.EveryNthFrom(n, n-1)
+}
+
+// EveryNthFrom returns every n-th element of a collection starting at the
+// element with the zero-based index offset, that is the elements at the
+// indexes offset, offset+n, offset+2n and so on. A negative offset is treated
+// as zero. If n is not positive, the result is an empty collection.
+func (q Query) EveryNthFrom(n, offset int) Query {
+	if offset < 0 {
+		offset = 0
+	}
+
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				if n <= 0 {
+					return
+				}
+
+				for item, ok = next(); ok; item, ok = next() {
+					index++
+					if index > offset && (index-offset-1)%n == 0 {
+						return
+					}
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// isNil reports whether item is nil or holds a nil pointer, map, slice,
+// channel, function or interface.
+func isNil(item interface{}) bool {
+	if item == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(item)
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func, reflect.Interface:
+		return v.IsNil()
+	}
+
+	return false
+}
+
+// Partition splits a collection into two collections based on a predicate. The
+// first collection contains the elements that satisfy the predicate, the
+// second one contains the elements that don't. Both collections preserve the
+// order of the source.
+//
+// The two collections share a single pass over the source: the first time
+// either of them is enumerated, the source is enumerated once, predicate is
+// invoked exactly once for each element, and both results are buffered. Later
+// enumerations of either collection replay the buffered results, so Partition
+// can be used with sources that can only be enumerated once, such as channels.
+func (q Query) Partition(predicate func(interface{}) bool) (matched, unmatched Query) {
+	var (
+		once  sync.Once
+		split [2][]interface{}
+	)
+
+	partition := func(want bool) Query {
+		return Query{
+			Iterate: func() Iterator {
+				once.Do(func() {
+					next := q.Iterate()
+					for item, ok := next(); ok; item, ok = next() {
+						if predicate(item) {
+							split[0] = append(split[0], item)
+						} else {
+							split[1] = append(split[1], item)
+						}
+					}
+				})
+
+				items := split[1]
+				if want {
+					items = split[0]
+				}
+
+				count := len(items)
+				index := 0
+
+				return func() (item interface{}, ok bool) {
+					ok = index < count
+					if ok {
+						item = items[index]
+						index++
+					}
+
+					return
+				}
+			},
+		}
+	}
+
+	return partition(true), partition(false)
+}
+
+// PartitionT is the typed version of Partition.
+//
+//   - predicateFn is of type "func(TSource)bool"
+//
+// NOTE: Partition has better performance than PartitionT.
+func (q Query) PartitionT(predicateFn interface{}) (matched, unmatched Query) {
+	predicateGenericFunc, err := newGenericFunc(
+		"PartitionT", "predicateFn", predicateFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	predicateFunc := func(item interface{}) bool {
+		return predicateGenericFunc.Call(item).(bool)
+	}
+
+	return q.Partition(predicateFunc)
+}
+
+// WhereCollect filters a collection of values based on a predicate that can
+// fail. Unlike Where, it doesn't stop at the first failure: every element is
+// tested, and the errors returned by predicate are collected in the order they
+// occurred. Elements for which predicate returns an error are left out of the
+// result.
+//
+// WhereCollect is not deferred: the source collection is enumerated when the
+// method is called, so that all the errors can be returned.
+func (q Query) WhereCollect(predicate func(interface{}) (bool, error)) (r Query, errs []error) {
+	next := q.Iterate()
+
+	var items []interface{}
+	for item, ok := next(); ok; item, ok = next() {
+		keep, err := predicate(item)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if keep {
+			items = append(items, item)
+		}
+	}
+
+	return From(items), errs
+}
+
+// WhereCollectT is the typed version of WhereCollect.
+//
+//   - predicateFn is of type "func(TSource)(bool,error)"
+//
+// NOTE: WhereCollect has better performance than WhereCollectT.
+func (q Query) WhereCollectT(predicateFn interface{}) (r Query, errs []error) {
+	predicateGenericFunc, err := newGenericFunc(
+		"WhereCollectT", "predicateFn", predicateFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(bool), new(error))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	predicateFunc := func(item interface{}) (bool, error) {
+		results := predicateGenericFunc.CallMulti(item)
+		err, _ := results[1].(error)
+		return results[0].(bool), err
+	}
+
+	return q.WhereCollect(predicateFunc)
+}
+
+// WhereCount filters a collection of values based on a predicate, and returns
+// the filtered collection together with the number of elements it contains.
+//
+// WhereCount is not deferred: the source collection is enumerated once when the
+// method is called, and the returned query iterates over the buffered result.
+func (q Query) WhereCount(predicate func(interface{}) bool) (r Query, count int) {
+	next := q.Iterate()
+
+	var items []interface{}
+	for item, ok := next(); ok; item, ok = next() {
+		if predicate(item) {
+			items = append(items, item)
+		}
+	}
+
+	return From(items), len(items)
+}
+
+// WhereCountT is the typed version of WhereCount.
+//
+//   - predicateFn is of type "func(TSource)bool"
+//
+// NOTE: WhereCount has better performance than WhereCountT.
+func (q Query) WhereCountT(predicateFn interface{}) (r Query, count int) {
+	predicateGenericFunc, err := newGenericFunc(
+		"WhereCountT", "predicateFn", predicateFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	predicateFunc := func(item interface{}) bool {
+		return predicateGenericFunc.Call(item).(bool)
+	}
+
+	return q.WhereCount(predicateFunc)
+}
+
+// WhereWithRejected filters a collection of values based on a predicate that
+// can fail, and returns both the elements that satisfy the predicate and the
+// elements that are rejected by it, each in the order of the source.
+//
+// WhereWithRejected is not deferred: the source collection is enumerated once
+// when the method is called, and predicate is invoked exactly once for each
+// element. The filtering stops at the first element for which predicate
+// returns an error, and that error is returned.
+func (q Query) WhereWithRejected(predicate func(interface{}) (bool, error)) (kept, rejected Query, err error) {
+	next := q.Iterate()
+
+	var keptItems, rejectedItems []interface{}
+	for item, ok := next(); ok; item, ok = next() {
+		keep, err := predicate(item)
+		if err != nil {
+			return Query{}, Query{}, err
+		}
+
+		if keep {
+			keptItems = append(keptItems, item)
+		} else {
+			rejectedItems = append(rejectedItems, item)
+		}
+	}
+
+	return From(keptItems), From(rejectedItems), nil
+}
+
+// WhereWithRejectedT is the typed version of WhereWithRejected.
+//
+//   - predicateFn is of type "func(TSource)(bool,error)"
+//
+// NOTE: WhereWithRejected has better performance than WhereWithRejectedT.
+func (q Query) WhereWithRejectedT(predicateFn interface{}) (kept, rejected Query, err error) {
+	predicateGenericFunc, err := newGenericFunc(
+		"WhereWithRejectedT", "predicateFn", predicateFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(bool), new(error))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	predicateFunc := func(item interface{}) (bool, error) {
+		results := predicateGenericFunc.CallMulti(item)
+		err, _ := results[1].(error)
+		return results[0].(bool), err
+	}
+
+	return q.WhereWithRejected(predicateFunc)
+}
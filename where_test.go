@@ -1,6 +1,10 @@
 package linq
 
-import "testing"
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
 
 func TestWhere(t *testing.T) {
 	tests := []struct {
@@ -55,3 +59,275 @@ func TestWhereIndexedT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
 		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).WhereIndexedT(func(item string) {})
 	})
 }
+
+func TestWhereNotNil(t *testing.T) {
+	var nilPtr *int
+	var nilSlice []int
+	one := 1
+
+	input := []interface{}{nil, 1, nilPtr, "a", nilSlice, &one, 0, nil}
+	want := []interface{}{1, "a", &one, 0}
+
+	if q := From(input).WhereNotNil(); !validateQuery(q, want) {
+		t.Errorf("From(%v).WhereNotNil()=%v expected %v", input, toSlice(q), want)
+	}
+}
+
+func TestCompact(t *testing.T) {
+	var nilPtr *int
+	one := 1
+
+	input := []interface{}{nil, 1, nilPtr, "", "a", 0.0, &one, 0, false, true, []int{}, foo{}}
+	want := []interface{}{1, "a", &one, true, []int{}}
+
+	if r := From(input).Compact().Results(); !reflect.DeepEqual(r, want) {
+		t.Errorf("From(%v).Compact()=%v expected %v", input, r, want)
+	}
+}
+
+func TestEveryNth(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		n      int
+		output []interface{}
+	}{
+		{[]int{1, 2, 3, 4, 5, 6, 7}, 3, []interface{}{3, 6}},
+		{[]int{1, 2, 3}, 1, []interface{}{1, 2, 3}},
+		{[]int{1, 2, 3}, 4, []interface{}{}},
+		{[]int{1, 2, 3}, 0, []interface{}{}},
+		{[]int{1, 2, 3}, -1, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).EveryNth(test.n); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).EveryNth(%d)=%v expected %v", test.input, test.n, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestEveryNthFrom(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		n      int
+		offset int
+		output []interface{}
+	}{
+		{[]int{1, 2, 3, 4, 5, 6, 7}, 3, 0, []interface{}{1, 4, 7}},
+		{[]int{1, 2, 3, 4, 5, 6, 7}, 2, 1, []interface{}{2, 4, 6}},
+		{[]int{1, 2, 3, 4, 5, 6, 7}, 2, -3, []interface{}{1, 3, 5, 7}},
+		{[]int{1, 2, 3}, 1, 5, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).EveryNthFrom(test.n, test.offset); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).EveryNthFrom(%d, %d)=%v expected %v", test.input, test.n, test.offset, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestPartition(t *testing.T) {
+	tests := []struct {
+		input     interface{}
+		predicate func(interface{}) bool
+		matched   []interface{}
+		unmatched []interface{}
+	}{
+		{[]int{1, 2, 3, 4, 5, 6}, func(i interface{}) bool {
+			return i.(int)%2 == 0
+		}, []interface{}{2, 4, 6}, []interface{}{1, 3, 5}},
+		{[]int{1, 3}, func(i interface{}) bool {
+			return i.(int) > 5
+		}, []interface{}{}, []interface{}{1, 3}},
+		{"sstr", func(i interface{}) bool {
+			return i.(rune) == 's'
+		}, []interface{}{'s', 's'}, []interface{}{'t', 'r'}},
+	}
+
+	for _, test := range tests {
+		matched, unmatched := From(test.input).Partition(test.predicate)
+		if !validateQuery(matched, test.matched) || !validateQuery(unmatched, test.unmatched) {
+			t.Errorf("From(%v).Partition()=%v,%v expected %v,%v", test.input,
+				toSlice(matched), toSlice(unmatched), test.matched, test.unmatched)
+		}
+	}
+}
+
+func TestPartition_InvokesPredicateOncePerElement(t *testing.T) {
+	calls := 0
+	matched, unmatched := From([]int{1, 2, 3, 4}).Partition(func(i interface{}) bool {
+		calls++
+		return i.(int) > 2
+	})
+
+	want := []interface{}{3, 4}
+	if r := matched.Results(); !reflect.DeepEqual(r, want) {
+		t.Errorf("Partition() matched=%v expected %v", r, want)
+	}
+
+	want = []interface{}{1, 2}
+	if r := unmatched.Results(); !reflect.DeepEqual(r, want) {
+		t.Errorf("Partition() unmatched=%v expected %v", r, want)
+	}
+
+	matched.Results()
+	if calls != 4 {
+		t.Errorf("Partition() invoked predicate %d times, expected 4", calls)
+	}
+}
+
+func TestPartition_ChannelSource(t *testing.T) {
+	ch := make(chan interface{}, 5)
+	for i := 1; i <= 5; i++ {
+		ch <- i
+	}
+	close(ch)
+
+	matched, unmatched := FromChannel(ch).Partition(func(i interface{}) bool {
+		return i.(int)%2 == 0
+	})
+
+	wantUnmatched := []interface{}{1, 3, 5}
+	if r := unmatched.Results(); !reflect.DeepEqual(r, wantUnmatched) {
+		t.Errorf("Partition() unmatched=%v expected %v", r, wantUnmatched)
+	}
+
+	wantMatched := []interface{}{2, 4}
+	if r := matched.Results(); !reflect.DeepEqual(r, wantMatched) {
+		t.Errorf("Partition() matched=%v expected %v", r, wantMatched)
+	}
+}
+
+func TestPartitionT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "PartitionT: parameter [predicateFn] has a invalid function signature. Expected: 'func(T)bool', actual: 'func(int)int'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).PartitionT(func(item int) int { return item + 2 })
+	})
+}
+
+func TestWhereCollect(t *testing.T) {
+	input := []interface{}{1, "a", 2, 3, "b", 4}
+	want := []interface{}{2, 4}
+	wantErrs := []error{errors.New("a"), errors.New("b")}
+
+	q, errs := From(input).WhereCollect(func(i interface{}) (bool, error) {
+		if s, ok := i.(string); ok {
+			return false, errors.New(s)
+		}
+
+		return i.(int)%2 == 0, nil
+	})
+
+	if !validateQuery(q, want) {
+		t.Errorf("From(%v).WhereCollect()=%v expected %v", input, toSlice(q), want)
+	}
+
+	if !reflect.DeepEqual(errs, wantErrs) {
+		t.Errorf("From(%v).WhereCollect() errors=%v expected %v", input, errs, wantErrs)
+	}
+}
+
+func TestWhereCollectT(t *testing.T) {
+	q, errs := From([]int{1, 2, 3, 4}).WhereCollectT(func(i int) (bool, error) {
+		if i == 3 {
+			return false, errors.New("three")
+		}
+
+		return i > 1, nil
+	})
+
+	if want := []interface{}{2, 4}; !validateQuery(q, want) || len(errs) != 1 {
+		t.Errorf("From([1 2 3 4]).WhereCollectT()=%v,%v expected %v,[three]", toSlice(q), errs, want)
+	}
+}
+
+func TestWhereCollectT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "WhereCollectT: parameter [predicateFn] has a invalid function signature. Expected: 'func(T)bool,error', actual: 'func(int)bool'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).WhereCollectT(func(item int) bool { return item > 2 })
+	})
+}
+
+func TestWhereCount(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		output []interface{}
+	}{
+		{[]int{1, 2, 3, 4, 5, 6}, []interface{}{2, 4, 6}},
+		{[]int{1, 3}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		calls := 0
+		q, count := From(test.input).WhereCount(func(i interface{}) bool {
+			calls++
+			return i.(int)%2 == 0
+		})
+
+		if count != len(test.output) || !validateQuery(q, test.output) {
+			t.Errorf("From(%v).WhereCount()=%v, %d expected %v, %d", test.input, toSlice(q), count, test.output, len(test.output))
+		}
+
+		if want := From(test.input).Count(); calls != want {
+			t.Errorf("WhereCount() invoked predicate %d times expected %d", calls, want)
+		}
+	}
+}
+
+func TestWhereCountT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "WhereCountT: parameter [predicateFn] has a invalid function signature. Expected: 'func(T)bool', actual: 'func(int)int'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).WhereCountT(func(item int) int { return item + 2 })
+	})
+}
+
+func TestWhereWithRejected(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5}
+	calls := 0
+
+	kept, rejected, err := From(input).WhereWithRejected(func(i interface{}) (bool, error) {
+		calls++
+		return i.(int)%2 == 0, nil
+	})
+
+	if err != nil {
+		t.Fatalf("From(%v).WhereWithRejected() returned error %v", input, err)
+	}
+
+	if want := []interface{}{2, 4}; !validateQuery(kept, want) {
+		t.Errorf("From(%v).WhereWithRejected() kept=%v expected %v", input, toSlice(kept), want)
+	}
+
+	if want := []interface{}{1, 3, 5}; !validateQuery(rejected, want) {
+		t.Errorf("From(%v).WhereWithRejected() rejected=%v expected %v", input, toSlice(rejected), want)
+	}
+
+	if calls != len(input) {
+		t.Errorf("WhereWithRejected() invoked predicate %d times expected %d", calls, len(input))
+	}
+
+	errInvalid := errors.New("invalid")
+	_, _, err = From([]interface{}{1, "a", 2}).WhereWithRejected(func(i interface{}) (bool, error) {
+		if _, ok := i.(int); !ok {
+			return false, errInvalid
+		}
+
+		return true, nil
+	})
+
+	if err != errInvalid {
+		t.Errorf("WhereWithRejected() error=%v expected %v", err, errInvalid)
+	}
+}
+
+func TestWhereWithRejectedT(t *testing.T) {
+	kept, rejected, err := From([]int{1, 2, 3, 4}).WhereWithRejectedT(func(i int) (bool, error) {
+		return i > 2, nil
+	})
+
+	if err != nil || !validateQuery(kept, []interface{}{3, 4}) || !validateQuery(rejected, []interface{}{1, 2}) {
+		t.Errorf("From([1 2 3 4]).WhereWithRejectedT()=%v,%v,%v expected [3 4],[1 2],<nil>", toSlice(kept), toSlice(rejected), err)
+	}
+}
+
+func TestWhereWithRejectedT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "WhereWithRejectedT: parameter [predicateFn] has a invalid function signature. Expected: 'func(T)bool,error', actual: 'func(int)bool'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).WhereWithRejectedT(func(item int) bool { return item > 2 })
+	})
+}
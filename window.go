@@ -0,0 +1,152 @@
+package linq
+
+import "math"
+
+// Window returns a collection of overlapping windows of a collection. Each
+// window is a slice of size contiguous elements, and the window moves forward
+// by one element at a time. For example, windows of size 2 over [1, 2, 3, 4]
+// are [1, 2], [2, 3] and [3, 4].
+//
+// Each produced window is a new slice, so it can be retained or modified
+// safely. If size is not positive, or if the collection contains fewer than
+// size elements, the result is an empty collection.
+func (q Query) Window(size int) Query {
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			var window []interface{}
+
+			return func() (item interface{}, ok bool) {
+				if size <= 0 {
+					return
+				}
+
+				if window == nil {
+					var buffer []interface{}
+					for len(buffer) < size {
+						it, ok := next()
+						if !ok {
+							return nil, false
+						}
+
+						buffer = append(buffer, it)
+					}
+
+					window = buffer
+					return window, true
+				}
+
+				it, ok := next()
+				if !ok {
+					return
+				}
+
+				buffer := make([]interface{}, size)
+				copy(buffer, window[1:])
+				buffer[size-1] = it
+				window = buffer
+
+				return window, true
+			}
+		},
+	}
+}
+
+// MovingAverage returns the averages of the overlapping windows of size
+// elements of a collection of numeric values, as the window moves forward by
+// one element at a time. Each average is a float64, and it is NaN if the window
+// contains a value that is not numeric.
+//
+// If size is not positive, or if the collection contains fewer than size
+// elements, the result is an empty collection.
+func (q Query) MovingAverage(size int) Query {
+	return q.Window(size).Select(func(window interface{}) interface{} {
+		sum := 0.0
+		for _, item := range window.([]interface{}) {
+			f, ok := toFloat64(item)
+			if !ok {
+				return math.NaN()
+			}
+
+			sum += f
+		}
+
+		return sum / float64(size)
+	})
+}
+
+// SlidingReduce applies reduce to each of the overlapping windows of size
+// elements of a collection, as the window moves forward by one element at a
+// time, and returns the collection of the reduced values. It generalizes
+// MovingAverage to any aggregate, such as the median or the maximum of each
+// window.
+//
+// SlidingReduce is not deferred: the source collection is enumerated and
+// reduced when the method is called. The reduction stops at the first window
+// for which reduce returns an error, and that error is returned. If size is
+// not positive, or if the collection contains fewer than size elements, the
+// result is an empty collection.
+func (q Query) SlidingReduce(size int,
+	reduce func(window []interface{}) (interface{}, error)) (Query, error) {
+	next := q.Window(size).Iterate()
+
+	var items []interface{}
+	for window, ok := next(); ok; window, ok = next() {
+		r, err := reduce(window.([]interface{}))
+		if err != nil {
+			return Query{}, err
+		}
+
+		items = append(items, r)
+	}
+
+	return From(items), nil
+}
+
+// SelectWindowed projects each element of a collection into a new form using a
+// selector that also receives the neighbors of the element: left holds up to
+// radius elements that precede it and right holds up to radius elements that
+// follow it, both in their original order. The neighbors are clamped at the
+// boundaries of the collection, so left is empty for the first element and
+// right is empty for the last one. If radius is negative, it is treated as
+// zero.
+//
+// left and right share storage with the buffered collection, so selector must
+// not modify them.
+//
+// SelectWindowed is not deferred: the source collection is enumerated and
+// projected when the method is called. The projection stops at the first
+// element for which selector returns an error, and that error is returned.
+func (q Query) SelectWindowed(radius int,
+	selector func(left []interface{}, elem interface{}, right []interface{}) (interface{}, error)) (Query, error) {
+	if radius < 0 {
+		radius = 0
+	}
+
+	items := q.Results()
+	if radius > len(items) {
+		radius = len(items)
+	}
+
+	r := make([]interface{}, 0, len(items))
+	for i, item := range items {
+		start := i - radius
+		if start < 0 {
+			start = 0
+		}
+
+		end := i + 1 + radius
+		if end > len(items) {
+			end = len(items)
+		}
+
+		p, err := selector(items[start:i:i], item, items[i+1:end:end])
+		if err != nil {
+			return Query{}, err
+		}
+
+		r = append(r, p)
+	}
+
+	return From(r), nil
+}
@@ -0,0 +1,184 @@
+package linq
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		size   int
+		output []interface{}
+	}{
+		{[]int{1, 2, 3, 4}, 2, []interface{}{
+			[]interface{}{1, 2}, []interface{}{2, 3}, []interface{}{3, 4},
+		}},
+		{[]int{1, 2, 3}, 3, []interface{}{[]interface{}{1, 2, 3}}},
+		{[]int{1, 2, 3}, 1, []interface{}{
+			[]interface{}{1}, []interface{}{2}, []interface{}{3},
+		}},
+		{[]int{1, 2, 3}, 4, nil},
+		{[]int{1, 2, 3}, maxInt, nil},
+		{[]int{1, 2, 3}, 0, nil},
+		{[]int{1, 2, 3}, -1, nil},
+		{"abc", 2, []interface{}{
+			[]interface{}{'a', 'b'}, []interface{}{'b', 'c'},
+		}},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).Window(test.size).Results(); !reflect.DeepEqual(r, test.output) {
+			t.Errorf("From(%v).Window(%v)=%v expected %v", test.input, test.size, r, test.output)
+		}
+	}
+}
+
+func TestWindow_WindowsAreIndependent(t *testing.T) {
+	r := From([]int{1, 2, 3}).Window(2).Results()
+	r[0].([]interface{})[1] = 0
+
+	if want := []interface{}{2, 3}; !reflect.DeepEqual(r[1], want) {
+		t.Errorf("From([1 2 3]).Window(2)[1]=%v expected %v", r[1], want)
+	}
+}
+
+func TestMovingAverage(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		size   int
+		output []interface{}
+	}{
+		{[]int{1, 2, 3, 4, 6}, 2, []interface{}{1.5, 2.5, 3.5, 5.0}},
+		{[]interface{}{1, 2.5, uint8(3)}, 3, []interface{}{6.5 / 3}},
+		{[]int{1, 2, 3}, 4, nil},
+		{[]int{1, 2, 3}, 0, nil},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).MovingAverage(test.size).Results(); !reflect.DeepEqual(r, test.output) {
+			t.Errorf("From(%v).MovingAverage(%d)=%v expected %v", test.input, test.size, r, test.output)
+		}
+	}
+
+	if r := From([]interface{}{1, "a"}).MovingAverage(2).First().(float64); !math.IsNaN(r) {
+		t.Errorf("MovingAverage() over non-numeric values=%v expected NaN", r)
+	}
+}
+
+func TestSlidingReduce(t *testing.T) {
+	max := func(window []interface{}) (interface{}, error) {
+		m := window[0].(int)
+		for _, item := range window[1:] {
+			if item.(int) > m {
+				m = item.(int)
+			}
+		}
+
+		return m, nil
+	}
+
+	tests := []struct {
+		input  interface{}
+		size   int
+		output []interface{}
+	}{
+		{[]int{1, 3, 2, 5, 4}, 2, []interface{}{3, 3, 5, 5}},
+		{[]int{1, 3, 2, 5, 4}, 3, []interface{}{3, 5, 5}},
+		{[]int{1, 2, 3}, 4, nil},
+		{[]int{1, 2, 3}, 0, nil},
+	}
+
+	for _, test := range tests {
+		q, err := From(test.input).SlidingReduce(test.size, max)
+		if err != nil {
+			t.Fatalf("From(%v).SlidingReduce(%d) returned error %v", test.input, test.size, err)
+		}
+
+		if r := q.Results(); !reflect.DeepEqual(r, test.output) {
+			t.Errorf("From(%v).SlidingReduce(%d)=%v expected %v", test.input, test.size, r, test.output)
+		}
+	}
+
+	errReduce := errors.New("reduce failed")
+	calls := 0
+	_, err := From([]int{1, 2, 3, 4}).SlidingReduce(2, func(window []interface{}) (interface{}, error) {
+		calls++
+		if window[1].(int) == 3 {
+			return nil, errReduce
+		}
+
+		return window[0], nil
+	})
+
+	if err != errReduce || calls != 2 {
+		t.Errorf("SlidingReduce() error=%v after %d calls expected %v after 2 calls", err, calls, errReduce)
+	}
+}
+
+func TestSelectWindowed(t *testing.T) {
+	neighbors := func(left []interface{}, elem interface{}, right []interface{}) (interface{}, error) {
+		return []interface{}{len(left), elem, len(right)}, nil
+	}
+
+	tests := []struct {
+		input  interface{}
+		radius int
+		output []interface{}
+	}{
+		{[]int{1, 2, 3, 4}, 1, []interface{}{
+			[]interface{}{0, 1, 1}, []interface{}{1, 2, 1}, []interface{}{1, 3, 1}, []interface{}{1, 4, 0},
+		}},
+		{[]int{1, 2, 3}, 5, []interface{}{
+			[]interface{}{0, 1, 2}, []interface{}{1, 2, 1}, []interface{}{2, 3, 0},
+		}},
+		{[]int{1, 2, 3}, maxInt, []interface{}{
+			[]interface{}{0, 1, 2}, []interface{}{1, 2, 1}, []interface{}{2, 3, 0},
+		}},
+		{[]int{1, 2}, -1, []interface{}{
+			[]interface{}{0, 1, 0}, []interface{}{0, 2, 0},
+		}},
+		{[]int{}, 1, nil},
+	}
+
+	for _, test := range tests {
+		q, err := From(test.input).SelectWindowed(test.radius, neighbors)
+		if err != nil {
+			t.Fatalf("From(%v).SelectWindowed(%d) returned error %v", test.input, test.radius, err)
+		}
+
+		if r := q.Results(); !reflect.DeepEqual(r, test.output) {
+			t.Errorf("From(%v).SelectWindowed(%d)=%v expected %v", test.input, test.radius, r, test.output)
+		}
+	}
+
+	smooth := func(left []interface{}, elem interface{}, right []interface{}) (interface{}, error) {
+		sum, n := elem.(int), 1
+		for _, item := range append(left, right...) {
+			sum += item.(int)
+			n++
+		}
+
+		return sum / n, nil
+	}
+
+	want := []interface{}{2, 3, 6, 8}
+	if q, _ := From([]int{1, 3, 5, 12}).SelectWindowed(1, smooth); !reflect.DeepEqual(q.Results(), want) {
+		t.Errorf("SelectWindowed() smoothing=%v expected %v", q.Results(), want)
+	}
+
+	errSelect := errors.New("select failed")
+	_, err := From([]int{1, 2, 3}).SelectWindowed(1, func(left []interface{}, elem interface{}, right []interface{}) (interface{}, error) {
+		if elem.(int) == 2 {
+			return nil, errSelect
+		}
+
+		return elem, nil
+	})
+
+	if err != errSelect {
+		t.Errorf("SelectWindowed() error=%v expected %v", err, errSelect)
+	}
+}
@@ -1,5 +1,7 @@
 package linq
 
+import "fmt"
+
 // Zip applies a specified function to the corresponding elements of two
 // collections, producing a collection of the results.
 //
@@ -53,3 +55,95 @@ func (q Query) ZipT(q2 Query,
 
 	return q.Zip(q2, resultSelectorFunc)
 }
+
+// CombineWith applies a specified function to the corresponding elements of two
+// collections, producing a collection of the results. Unlike Zip, the result
+// has as many elements as the longer collection: once the shorter collection
+// is exhausted, its last element is combined with each of the remaining
+// elements of the longer one. If either collection is empty, the result is an
+// empty collection.
+func (q Query) CombineWith(q2 Query,
+	resultSelector func(interface{}, interface{}) interface{}) Query {
+
+	return Query{
+		Iterate: func() Iterator {
+			next1 := q.Iterate()
+			next2 := q2.Iterate()
+			var last1, last2 interface{}
+			started := false
+
+			return func() (item interface{}, ok bool) {
+				item1, ok1 := next1()
+				item2, ok2 := next2()
+
+				if !started {
+					if !ok1 || !ok2 {
+						return nil, false
+					}
+
+					started = true
+				}
+
+				if !ok1 && !ok2 {
+					return nil, false
+				}
+
+				if ok1 {
+					last1 = item1
+				}
+
+				if ok2 {
+					last2 = item2
+				}
+
+				return resultSelector(last1, last2), true
+			}
+		},
+	}
+}
+
+// CombineWithT is the typed version of CombineWith.
+//
+//   - resultSelectorFn is of type "func(TFirst,TSecond)TResult"
+//
+// NOTE: CombineWith has better performance than CombineWithT.
+func (q Query) CombineWithT(q2 Query,
+	resultSelectorFn interface{}) Query {
+	resultSelectorGenericFunc, err := newGenericFunc(
+		"CombineWithT", "resultSelectorFn", resultSelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType), new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	resultSelectorFunc := func(item1 interface{}, item2 interface{}) interface{} {
+		return resultSelectorGenericFunc.Call(item1, item2)
+	}
+
+	return q.CombineWith(q2, resultSelectorFunc)
+}
+
+// Unzip splits a collection of KeyValue elements into two collections: one with
+// the keys and one with the values, in the same order. It is the inverse of
+// zipping two collections into KeyValue pairs.
+//
+// Unzip is not deferred: the source collection is enumerated when the method is
+// called. An error wrapping ErrTypeMismatch is returned if an element is not a
+// KeyValue.
+func (q Query) Unzip() (keys Query, values Query, err error) {
+	next := q.Iterate()
+
+	var k, v []interface{}
+	for item, ok := next(); ok; item, ok = next() {
+		pair, ok := item.(KeyValue)
+		if !ok {
+			return Query{}, Query{}, fmt.Errorf("Unzip: %w: element %d is a %T", ErrTypeMismatch, len(k), item)
+		}
+
+		k = append(k, pair.Key)
+		v = append(v, pair.Value)
+	}
+
+	return From(k), From(v), nil
+}
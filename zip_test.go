@@ -1,6 +1,9 @@
 package linq
 
-import "testing"
+import (
+	"errors"
+	"testing"
+)
 
 func TestZip(t *testing.T) {
 	input1 := []int{1, 2, 3}
@@ -24,3 +27,48 @@ func TestZipT_PanicWhenResultSelectorFnIsInvalid(t *testing.T) {
 		})
 	})
 }
+
+func TestCombineWith(t *testing.T) {
+	add := func(i, j interface{}) interface{} {
+		return i.(int) + j.(int)
+	}
+
+	tests := []struct {
+		input1 []int
+		input2 []int
+		output []interface{}
+	}{
+		{[]int{1, 2, 3}, []int{10, 20, 30, 40, 50}, []interface{}{11, 22, 33, 43, 53}},
+		{[]int{1, 2, 3, 4}, []int{10}, []interface{}{11, 12, 13, 14}},
+		{[]int{1, 2}, []int{10, 20}, []interface{}{11, 22}},
+		{[]int{}, []int{10, 20}, []interface{}{}},
+		{[]int{1, 2}, []int{}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input1).CombineWith(From(test.input2), add); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).CombineWith(%v)=%v expected %v", test.input1, test.input2, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestCombineWithT_PanicWhenResultSelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "CombineWithT: parameter [resultSelectorFn] has a invalid function signature. Expected: 'func(T,T)T', actual: 'func(int,int,int)int'", func() {
+		From([]int{1, 2, 3}).CombineWithT(From([]int{2, 4, 5, 1}), func(i, j, k int) int {
+			return i + j
+		})
+	})
+}
+
+func TestUnzip(t *testing.T) {
+	input := []KeyValue{{"a", 1}, {"b", 2}, {"c", 3}}
+
+	keys, values, err := From(input).Unzip()
+	if err != nil || !validateQuery(keys, []interface{}{"a", "b", "c"}) || !validateQuery(values, []interface{}{1, 2, 3}) {
+		t.Errorf("From(%v).Unzip()=%v, %v, %v expected [a b c], [1 2 3], <nil>", input, toSlice(keys), toSlice(values), err)
+	}
+
+	if _, _, err := From([]interface{}{KeyValue{"a", 1}, 2}).Unzip(); !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("Unzip() error=%v expected ErrTypeMismatch", err)
+	}
+}